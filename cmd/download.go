@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/requestid"
+	"go-graph/pkg/tenant"
+	"go-graph/service"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// downloadHandler serves attachment files for the signed links handed out
+// with attachments: DownloadPath + id ?tenant= &expires= &signature=.
+func downloadHandler(svc *service.ServiceAttachment) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		q := r.URL.Query()
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, service.DownloadPath))
+		if err != nil {
+			writeDownloadError(w, r, service.ErrAttachmentNotFound)
+			return
+		}
+		expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+		if err != nil {
+			writeDownloadError(w, r, service.ErrInvalidDownloadLink)
+			return
+		}
+		// the tenant is part of the signature, a forged one fails to verify
+		ctx := tenant.WithID(r.Context(), q.Get("tenant"))
+		attachment, body, err := svc.Download(ctx, id, expires, q.Get("signature"))
+		if err != nil {
+			writeDownloadError(w, r, err)
+			return
+		}
+		defer body.Close()
+		h := w.Header()
+		h.Set("Content-Type", attachment.ContentType)
+		h.Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+		h.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("Cache-Control", "private, max-age=0")
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			log.Err(err).Str("request_id", requestid.FromContext(r.Context())).Msg("download attachment error")
+		}
+	})
+}
+
+func writeDownloadError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	switch apperr.CodeOf(err) {
+	case apperr.CodeNotFound:
+		status = http.StatusNotFound
+	case apperr.CodeForbidden:
+		status = http.StatusForbidden
+	case apperr.CodeValidation:
+		status = http.StatusBadRequest
+	default:
+		log.Err(err).Str("request_id", requestid.FromContext(r.Context())).Msg("download attachment error")
+		err = apperr.Internal(nil)
+	}
+	apperr.WriteHTTP(w, status, err)
+}
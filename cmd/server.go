@@ -1,18 +1,34 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"go-graph/graph/directive"
 	"go-graph/graph/generated"
+	"go-graph/graph/loader"
 	"go-graph/graph/resolver"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/auth"
 	"go-graph/pkg/config"
+	"go-graph/pkg/requestid"
 	"go-graph/pkg/splitlog"
+	"go-graph/pkg/tenant"
+	"go-graph/service"
 	"net/http"
+	"runtime/debug"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/urfave/cli/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 var (
@@ -34,17 +50,224 @@ func startServer(ctx *cli.Context) error {
 		return err
 	}
 	initSplitLog(logLevel)
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
-		Resolvers: resolver.New(),
-	}))
+	verifier, err := newVerifier(conf)
+	if err != nil {
+		return err
+	}
+	res := resolver.New()
+	startTrashRetention(ctx.Context, res.TodoService(), conf.GetTrashRetention())
+	startReminders(ctx.Context, res.TodoService(), service.LogNotifier{}, conf.GetReminderInterval())
+	startRecurrences(ctx.Context, res.TodoService(), conf.GetRecurrenceInterval())
+	srv := newGraphQLServer(generated.NewExecutableSchema(res.Config()), verifier, conf)
+	srv.Use(loader.Extension{New: res.NewLoaders})
 
 	http.Handle("/graphql", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	http.Handle("/query", requestid.Middleware(auth.Middleware(verifier, tenant.Middleware(srv))))
+	// download links are signed and need no token
+	http.Handle(service.DownloadPath, requestid.Middleware(downloadHandler(res.AttachmentService())))
 	log.Info().Msgf("connect to http://localhost:%s/ for GraphQL playground", conf.GetPort())
 	http.ListenAndServe(":"+conf.GetPort(), nil)
 	return nil
 }
 
+// newVerifier accepts the token algorithms that have a key configured. With
+// neither every request is anonymous.
+func newVerifier(conf config.ServerConfig) (*auth.Verifier, error) {
+	var opts []auth.VerifierOption
+	if secret := conf.GetJWTSecret(); secret != "" {
+		opts = append(opts, auth.WithHS256([]byte(secret)))
+	}
+	if file := conf.GetJWKSFile(); file != "" {
+		keys, err := auth.LoadJWKS(file)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, auth.WithRS256(keys))
+	}
+	if len(opts) == 0 {
+		log.Warn().Msg("no jwt-secret or jwks-file configured, bearer tokens are rejected")
+	}
+	if iss := conf.GetJWTIssuer(); iss != "" {
+		opts = append(opts, auth.WithIssuer(iss))
+	}
+	if aud := conf.GetJWTAudience(); aud != "" {
+		opts = append(opts, auth.WithAudience(aud))
+	}
+	return auth.NewVerifier(opts...), nil
+}
+
+// trashPurgeInterval is how often expired todos are purged from the trash.
+const trashPurgeInterval = time.Hour
+
+// startTrashRetention periodically purges todos deleted longer than
+// retention ago. A zero retention keeps deleted todos forever.
+func startTrashRetention(ctx context.Context, svc *service.ServiceTodo, retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(trashPurgeInterval)
+		defer ticker.Stop()
+		for {
+			n, err := svc.PurgeExpiredTodos(ctx, retention)
+			if err != nil {
+				log.Err(err).Msg("purge expired todos error")
+			} else if n > 0 {
+				log.Info().Int64("count", n).Msg("purged expired todos")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// startReminders delivers due reminders through notifier every interval.
+// A zero interval disables reminders.
+func startReminders(ctx context.Context, svc *service.ServiceTodo, notifier service.Notifier, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			n, err := svc.SendDueReminders(ctx, notifier, time.Now())
+			if err != nil {
+				log.Err(err).Msg("send due reminders error")
+			} else if n > 0 {
+				log.Info().Int("count", n).Msg("sent due reminders")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// startRecurrences spawns the next occurrence of due recurring todos every
+// interval. A zero interval leaves it to completing them.
+func startRecurrences(ctx context.Context, svc *service.ServiceTodo, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			n, err := svc.SpawnDueRecurrences(ctx, time.Now())
+			if err != nil {
+				log.Err(err).Msg("spawn recurring todos error")
+			} else if n > 0 {
+				log.Info().Int("count", n).Msg("spawned recurring todos")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// newGraphQLServer builds the same stack as handler.NewDefaultServer, spelled
+// out so the websocket transport serving subscriptions can be tuned here.
+// Subscriptions authenticate with verifier through their init payload;
+// file uploads are bounded by the upload limits of conf.
+func newGraphQLServer(es graphql.ExecutableSchema, verifier *auth.Verifier, conf config.ServerConfig) *handler.Server {
+	srv := handler.New(es)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              tenant.WebsocketInit(auth.WebsocketInit(verifier)),
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: conf.GetUploadMaxSize(),
+		MaxMemory:     conf.GetUploadMaxMemory(),
+	})
+
+	srv.SetQueryCache(lru.New(1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(directive.Validation{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+
+	srv.SetErrorPresenter(presentError)
+	srv.SetRecoverFunc(recoverPanic)
+	return srv
+}
+
+// presentError sets extensions.code on every error. Errors carrying an
+// apperr code keep their message; anything else is logged with the request
+// id and masked so database internals never reach the client.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var appErr *apperr.Error
+	switch {
+	case errors.As(err, &appErr) && appErr.Code != apperr.CodeInternal:
+		setErrorCode(gqlErr, appErr.Code)
+		for k, v := range appErr.Details {
+			gqlErr.Extensions[k] = v
+		}
+	case errors.As(err, &appErr):
+		logInternalError(ctx, gqlErr, appErr.Cause)
+	case isInputError(ctx, err):
+		setErrorCode(gqlErr, apperr.CodeValidation)
+	default:
+		logInternalError(ctx, gqlErr, err)
+	}
+	return gqlErr
+}
+
+// recoverPanic turns a resolver panic into an internal error; presentError
+// logs it together with the stack and masks it.
+func recoverPanic(ctx context.Context, p interface{}) error {
+	return apperr.Internal(fmt.Errorf("panic: %v\n%s", p, debug.Stack()))
+}
+
+func logInternalError(ctx context.Context, gqlErr *gqlerror.Error, cause error) {
+	log.Error().
+		Err(cause).
+		Str("request_id", requestid.FromContext(ctx)).
+		Str("path", gqlErr.Path.String()).
+		Msg("graphql internal error")
+	gqlErr.Message = "internal server error"
+	setErrorCode(gqlErr, apperr.CodeInternal)
+	gqlErr.Extensions["requestId"] = requestid.FromContext(ctx)
+}
+
+func setErrorCode(gqlErr *gqlerror.Error, code apperr.Code) {
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+	gqlErr.Extensions["code"] = string(code)
+}
+
+// isInputError reports whether err was built by gqlgen itself, either
+// without a cause or while coercing an argument, as opposed to being
+// returned by a resolver. Coercion errors point below the path of the field
+// whose argument failed; errors a resolver wraps in a gqlerror.Error don't.
+func isInputError(ctx context.Context, err error) bool {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		return false
+	}
+	if gqlErr.Unwrap() == nil {
+		return true
+	}
+	fc := graphql.GetFieldContext(ctx)
+	return fc != nil && len(gqlErr.Path) > len(fc.Path())
+}
+
 func initSplitLog(logLevel zerolog.Level) {
 	yy, mm, dd := time.Now().Date()
 	tomorrowMidNight := time.Date(yy, mm, dd+1, 0, 0, 0, 0, time.Local)
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/requestid"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func fieldContext() context.Context {
+	ctx := requestid.WithID(context.Background(), "req-1")
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: &ast.Field{Alias: "gettodo"}},
+	})
+}
+
+func TestPresentDomainError(t *testing.T) {
+	invalid := apperr.New(apperr.CodeValidation, "invalid input")
+	gqlErr := presentError(fieldContext(), fmt.Errorf("%w: text must not be empty", invalid))
+	assert.Equal(t, "invalid input: text must not be empty", gqlErr.Message)
+	assert.Equal(t, "VALIDATION", gqlErr.Extensions["code"])
+	assert.Equal(t, ast.Path{ast.PathName("gettodo")}, gqlErr.Path)
+}
+
+func TestPresentErrorDetails(t *testing.T) {
+	conflict := apperr.New(apperr.CodeConflict, "todo was changed")
+	conflict.Details = map[string]any{"current": map[string]int{"version": 4}}
+	gqlErr := presentError(fieldContext(), conflict)
+	assert.Equal(t, "CONFLICT", gqlErr.Extensions["code"])
+	assert.Equal(t, map[string]int{"version": 4}, gqlErr.Extensions["current"])
+}
+
+func TestPresentMasksInternalError(t *testing.T) {
+	gqlErr := presentError(fieldContext(), errors.New(`pq: relation "todos" does not exist`))
+	assert.Equal(t, "internal server error", gqlErr.Message)
+	assert.Equal(t, "INTERNAL", gqlErr.Extensions["code"])
+	assert.Equal(t, "req-1", gqlErr.Extensions["requestId"])
+}
+
+func TestPresentCoercionError(t *testing.T) {
+	path := ast.Path{ast.PathName("gettodo"), ast.PathName("after")}
+	gqlErr := presentError(fieldContext(), gqlerror.WrapPath(path, errors.New("Cursor must be a value returned by an earlier page")))
+	assert.Equal(t, "Cursor must be a value returned by an earlier page", gqlErr.Message)
+	assert.Equal(t, "VALIDATION", gqlErr.Extensions["code"])
+}
+
+func TestPresentMasksWrappedResolverError(t *testing.T) {
+	path := ast.Path{ast.PathName("gettodo")}
+	gqlErr := presentError(fieldContext(), gqlerror.WrapPath(path, errors.New(`pq: relation "todos" does not exist`)))
+	assert.Equal(t, "internal server error", gqlErr.Message)
+	assert.Equal(t, "INTERNAL", gqlErr.Extensions["code"])
+}
+
+func TestRecoverPanic(t *testing.T) {
+	err := recoverPanic(fieldContext(), "boom")
+	gqlErr := presentError(fieldContext(), err)
+	assert.Equal(t, "internal server error", gqlErr.Message)
+	assert.Equal(t, "INTERNAL", gqlErr.Extensions["code"])
+}
@@ -8,15 +8,38 @@ import (
 	"log"
 )
 
+// statements run after AutoMigrate for schema objects gorm cannot express.
+// Each one must be idempotent.
+var statements = []string{
+	fmt.Sprintf(`ALTER TABLE todos ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (to_tsvector('%s', coalesce(title, ''))) STORED`, model.TodoSearchConfig),
+	`CREATE INDEX IF NOT EXISTS idx_todos_search_vector ON todos USING GIN (search_vector)`,
+	// tag names are unique per tenant since idx_tags_tenant_name, and only
+	// among live tags since idx_tags_tenant_live_name
+	`DROP INDEX IF EXISTS idx_tags_name`,
+	`DROP INDEX IF EXISTS idx_tags_tenant_name`,
+}
+
 func main() {
 	if err := config.InitDefaultGormConfig(); err != nil {
 		panic(err)
 	}
 	conn := db.GetConnection()
 	if err := conn.AutoMigrate(
+		&model.User{},
+		&model.Project{},
 		&model.Todo{},
+		&model.Tag{},
+		&model.AuditEvent{},
+		&model.Comment{},
+		&model.Attachment{},
 	); err != nil {
 		panic(fmt.Errorf("automatically migrate database failed %v", err))
 	}
+	for _, stmt := range statements {
+		if err := conn.Exec(stmt).Error; err != nil {
+			panic(fmt.Errorf("migrate statement failed %v", err))
+		}
+	}
 	log.Println("migrate tables created...")
 }
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"go-graph/db"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Attachment is a file uploaded to a todo. The contents live in blob
+// storage under StorageKey; the row keeps what is needed to serve them.
+type Attachment struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	TenantID  string    `json:"tenantId" gorm:"size:64;not null;default:default;index"`
+	TodoID    uint      `json:"todoId" gorm:"not null;index"`
+	Todo      *Todo     `json:"todo,omitempty"`
+	// Filename is the base name given by the uploader.
+	Filename string `json:"filename" gorm:"size:255;not null"`
+	// ContentType is sniffed from the contents, never taken from the client.
+	ContentType string `json:"contentType" gorm:"size:255;not null"`
+	Size        int64  `json:"size" gorm:"not null"`
+	// Checksum is the hex encoded SHA-256 of the contents.
+	Checksum   string `json:"checksum" gorm:"size:64;not null"`
+	StorageKey string `json:"-" gorm:"size:255;not null;uniqueIndex"`
+}
+
+type AttachmentRepo interface {
+	Base[Attachment]
+	FindByTodoIds(ctx context.Context, todoIDs []uint) ([]*Attachment, error)
+}
+
+type attachmentRepo struct {
+	base[Attachment]
+}
+
+func NewDefaultAttachmentRepo() AttachmentRepo {
+	return NewAttachmentRepo(db.GetConnection())
+}
+
+func NewAttachmentRepo(db *gorm.DB) AttachmentRepo {
+	return &attachmentRepo{base: base[Attachment]{db: db}}
+}
+
+// FindByTodoIds lists the attachments of several todos, each todo's in
+// upload order.
+func (r *attachmentRepo) FindByTodoIds(ctx context.Context, todoIDs []uint) ([]*Attachment, error) {
+	var a []*Attachment
+	if err := r.conn(ctx).Where("todo_id IN ?", todoIDs).Order("id").Find(&a).Error; err != nil {
+		return nil, err
+	}
+	return a, nil
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindAttachmentsByTodoIds(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewAttachmentRepo(db)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "attachments" WHERE "attachments"."tenant_id" = $1 AND todo_id IN ($2,$3) ORDER BY id`)).
+		WithArgs("acme", 1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "todo_id", "filename"}).AddRow(1, 1, "screen.png"))
+	attachments, err := r.FindByTodoIds(acme(), []uint{1, 2})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "screen.png", attachments[0].Filename)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
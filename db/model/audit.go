@@ -0,0 +1,347 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go-graph/db"
+	"go-graph/pkg/audit"
+	"go-graph/pkg/requestid"
+	"reflect"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// AuditOp is the kind of write an AuditEvent records.
+type AuditOp string
+
+const (
+	AuditCreate AuditOp = "create"
+	AuditUpdate AuditOp = "update"
+	AuditDelete AuditOp = "delete"
+)
+
+// AuditEvent is one row written by one statement. Before and After are JSON
+// snapshots of the row; Before is null for creates and After for hard
+// deletes.
+type AuditEvent struct {
+	ID        uint      `gorm:"primarykey"`
+	CreatedAt time.Time `gorm:"index"`
+	TenantID  string    `gorm:"size:64;not null;default:default;index"`
+	Entity    string    `gorm:"index:idx_audit_events_entity"`
+	EntityID  uint      `gorm:"index:idx_audit_events_entity"`
+	Op        AuditOp
+	Actor     string `gorm:"index"`
+	RequestID string
+	Before    json.RawMessage `gorm:"type:jsonb"`
+	After     json.RawMessage `gorm:"type:jsonb"`
+}
+
+// AuditFilter narrows FindFiltered results; zero fields are ignored.
+type AuditFilter struct {
+	Entity   string
+	EntityID *uint
+	Actor    string
+	Op       AuditOp
+	After    *time.Time
+	Before   *time.Time
+}
+
+type AuditRepo interface {
+	FindFiltered(ctx context.Context, filter AuditFilter, limit int) ([]*AuditEvent, error)
+	FindByEntityIds(ctx context.Context, entity string, ids []uint) ([]*AuditEvent, error)
+}
+
+type auditRepo struct {
+	db *gorm.DB
+}
+
+func NewDefaultAuditRepo() AuditRepo {
+	return NewAuditRepo(db.GetConnection())
+}
+
+func NewAuditRepo(db *gorm.DB) AuditRepo {
+	return &auditRepo{db: db}
+}
+
+// FindFiltered lists the latest matching events, newest first.
+func (r *auditRepo) FindFiltered(ctx context.Context, filter AuditFilter, limit int) ([]*AuditEvent, error) {
+	q := scoped(ctx, r.db)
+	if filter.Entity != "" {
+		q = q.Where("entity = ?", filter.Entity)
+	}
+	if filter.EntityID != nil {
+		q = q.Where("entity_id = ?", *filter.EntityID)
+	}
+	if filter.Actor != "" {
+		q = q.Where("actor = ?", filter.Actor)
+	}
+	if filter.Op != "" {
+		q = q.Where("op = ?", filter.Op)
+	}
+	if filter.After != nil {
+		q = q.Where("created_at >= ?", *filter.After)
+	}
+	if filter.Before != nil {
+		q = q.Where("created_at < ?", *filter.Before)
+	}
+	var events []*AuditEvent
+	if err := q.Order("id DESC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindByEntityIds loads the history of several rows of one table in one
+// query, oldest first.
+func (r *auditRepo) FindByEntityIds(ctx context.Context, entity string, ids []uint) ([]*AuditEvent, error) {
+	var events []*AuditEvent
+	err := scoped(ctx, r.db).
+		Where("entity = ? AND entity_id IN ?", entity, ids).
+		Order("id").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// auditSnapshotsKey carries the before snapshots from the before callback
+// to the after callback of the same statement.
+const auditSnapshotsKey = "audit:before"
+
+// Auditor is a gorm plugin writing an AuditEvent for every row created,
+// updated or deleted, in the transaction of the write itself. Rows are
+// found through the primary keys of the statement model, or through its
+// WHERE clause when the model carries none; those rows are locked and the
+// write is restricted to them. Updates that leave a row unchanged are not
+// recorded.
+type Auditor struct{}
+
+func (Auditor) Name() string {
+	return "audit"
+}
+
+func (a Auditor) Initialize(db *gorm.DB) error {
+	const commit = "gorm:commit_or_rollback_transaction"
+	cb := db.Callback()
+	if err := cb.Create().After("gorm:create").Before(commit).Register("audit:after_create", a.after(AuditCreate)); err != nil {
+		return err
+	}
+	if err := cb.Update().Before("gorm:update").Register("audit:before_update", a.before); err != nil {
+		return err
+	}
+	if err := cb.Update().After("gorm:update").Before(commit).Register("audit:after_update", a.after(AuditUpdate)); err != nil {
+		return err
+	}
+	if err := cb.Delete().Before("gorm:delete").Register("audit:before_delete", a.before); err != nil {
+		return err
+	}
+	return cb.Delete().After("gorm:delete").Before(commit).Register("audit:after_delete", a.after(AuditDelete))
+}
+
+func (a Auditor) before(db *gorm.DB) {
+	if !audited(db) {
+		return
+	}
+	ids := primaryKeys(db.Statement)
+	where, hasWhere := db.Statement.Clauses["WHERE"]
+	if len(ids) == 0 && !hasWhere {
+		return
+	}
+	q := db.Session(&gorm.Session{NewDB: true}).Unscoped()
+	if len(ids) > 0 {
+		q = q.Where(clause.IN{Column: clause.PrimaryColumn, Values: ids})
+	} else {
+		q = q.Clauses(where.Expression, clause.Locking{Strength: "UPDATE"})
+	}
+	snapshots, err := snapshot(q, db.Statement.Schema)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+	if len(ids) == 0 {
+		// evaluated again, the WHERE clause may select other rows, e.g.
+		// through a SKIP LOCKED subquery, so the write is narrowed to the
+		// rows locked by the snapshot
+		locked := make([]uint, 0, len(snapshots))
+		for id := range snapshots {
+			locked = append(locked, id)
+		}
+		sort.Slice(locked, func(i, j int) bool { return locked[i] < locked[j] })
+		keys := make([]any, len(locked))
+		for i, id := range locked {
+			keys[i] = id
+		}
+		db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+			clause.IN{Column: clause.PrimaryColumn, Values: keys},
+		}})
+	}
+	db.InstanceSet(auditSnapshotsKey, snapshots)
+}
+
+func (a Auditor) after(op AuditOp) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if !audited(db) || db.Error != nil || db.Statement.RowsAffected == 0 {
+			return
+		}
+		var before map[uint]auditRow
+		if v, ok := db.InstanceGet(auditSnapshotsKey); ok {
+			before = v.(map[uint]auditRow)
+		}
+		ids := primaryKeys(db.Statement)
+		if op != AuditCreate {
+			ids = ids[:0]
+			for id := range before {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return
+		}
+		q := db.Session(&gorm.Session{NewDB: true}).Unscoped().
+			Where(clause.IN{Column: clause.PrimaryColumn, Values: ids})
+		after, err := snapshot(q, db.Statement.Schema)
+		if err != nil {
+			db.AddError(err)
+			return
+		}
+		ctx := db.Statement.Context
+		var events []*AuditEvent
+		for _, v := range ids {
+			id := v.(uint)
+			if op == AuditUpdate && bytes.Equal(before[id].data, after[id].data) {
+				continue
+			}
+			events = append(events, newAuditEvent(ctx, db.Statement.Schema.Table, id, op, before[id], after[id]))
+		}
+		if len(events) == 0 {
+			return
+		}
+		err = db.Session(&gorm.Session{NewDB: true, SkipDefaultTransaction: true}).Create(&events).Error
+		if err != nil {
+			db.AddError(err)
+		}
+	}
+}
+
+func newAuditEvent(ctx context.Context, table string, id uint, op AuditOp, before, after auditRow) *AuditEvent {
+	tenantID := after.tenant
+	if tenantID == "" {
+		tenantID = before.tenant
+	}
+	return &AuditEvent{
+		TenantID:  tenantID,
+		Entity:    table,
+		EntityID:  id,
+		Op:        op,
+		Actor:     audit.ActorFromContext(ctx),
+		RequestID: requestid.FromContext(ctx),
+		Before:    before.data,
+		After:     after.data,
+	}
+}
+
+// auditAssociation records a write to the many2many association name of
+// row id of model, which the Auditor cannot see on the join table, as an
+// update of the row. change runs between the snapshots, which include the
+// association; nothing is recorded when it leaves the row unchanged.
+func auditAssociation(tx *gorm.DB, model any, id uint, name string, change func() error) error {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(model); err != nil {
+		return err
+	}
+	q := func() (map[uint]auditRow, error) {
+		return snapshot(tx.Session(&gorm.Session{NewDB: true}).Unscoped().Preload(name).
+			Where(clause.IN{Column: clause.PrimaryColumn, Values: []any{id}}), stmt.Schema)
+	}
+	before, err := q()
+	if err != nil {
+		return err
+	}
+	if err := change(); err != nil {
+		return err
+	}
+	after, err := q()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(before[id].data, after[id].data) {
+		return nil
+	}
+	event := newAuditEvent(tx.Statement.Context, stmt.Schema.Table, id, AuditUpdate, before[id], after[id])
+	return tx.Session(&gorm.Session{NewDB: true}).Create(event).Error
+}
+
+// audited reports whether the statement writes rows of a table with a
+// single uint primary key, other than the audit log itself.
+func audited(db *gorm.DB) bool {
+	s := db.Statement.Schema
+	if s == nil || s.Table == auditEventsTable || s.PrioritizedPrimaryField == nil {
+		return false
+	}
+	return s.PrioritizedPrimaryField.FieldType.Kind() == reflect.Uint
+}
+
+const auditEventsTable = "audit_events"
+
+// primaryKeys returns the non-zero primary keys of the statement model,
+// which is a struct or a slice of them.
+func primaryKeys(stmt *gorm.Statement) []any {
+	pk := stmt.Schema.PrioritizedPrimaryField
+	rv := reflect.Indirect(stmt.ReflectValue)
+	var ids []any
+	add := func(v reflect.Value) {
+		if id, zero := pk.ValueOf(stmt.Context, v); !zero {
+			ids = append(ids, id)
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		add(rv)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if v := reflect.Indirect(rv.Index(i)); v.Kind() == reflect.Struct {
+				add(v)
+			}
+		}
+	}
+	return ids
+}
+
+// auditRow is the JSON snapshot of a row and the tenant it belongs to,
+// empty for tables that are not partitioned.
+type auditRow struct {
+	data   json.RawMessage
+	tenant string
+}
+
+// snapshot loads the rows selected by q keyed by primary key.
+func snapshot(q *gorm.DB, s *schema.Schema) (map[uint]auditRow, error) {
+	rows := reflect.New(reflect.SliceOf(reflect.PtrTo(s.ModelType)))
+	if err := q.Find(rows.Interface()).Error; err != nil {
+		return nil, err
+	}
+	tenantField := s.LookUpField(tenantColumn)
+	snapshots := make(map[uint]auditRow, rows.Elem().Len())
+	for i := 0; i < rows.Elem().Len(); i++ {
+		row := rows.Elem().Index(i)
+		id, _ := s.PrioritizedPrimaryField.ValueOf(q.Statement.Context, row)
+		b, err := json.Marshal(row.Interface())
+		if err != nil {
+			return nil, err
+		}
+		r := auditRow{data: b}
+		if tenantField != nil {
+			if v, zero := tenantField.ValueOf(q.Statement.Context, row); !zero {
+				r.tenant, _ = v.(string)
+			}
+		}
+		snapshots[id.(uint)] = r
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"context"
+	"go-graph/pkg/audit"
+	"go-graph/pkg/requestid"
+	"go-graph/pkg/tenant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// auditedDB opens a gorm connection on the shared mock with the Auditor
+// plugin installed.
+func auditedDB(t *testing.T) *gorm.DB {
+	conn, err := gorm.Open(postgres.New(postgres.Config{Conn: mockDB}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, conn.Use(Auditor{}))
+	return conn
+}
+
+func TestAuditUpdate(t *testing.T) {
+	r := NewTodoRepo(auditedDB(t))
+	ctx := requestid.WithID(audit.WithActor(context.Background(), "alice"), "req-1")
+	mockSQL.MatchExpectationsInOrder(true)
+	defer mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE "todos"."id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "version"}).AddRow(1, "old", 1))
+	mockSQL.ExpectExec(regexp.QuoteMeta(`UPDATE "todos" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE "todos"."id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "version"}).AddRow(1, "new", 2))
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "audit_events" ("created_at","tenant_id","entity","entity_id","op","actor","request_id","before","after") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
+		WithArgs(sqlmock.AnyArg(), tenant.Default, "todos", 1, AuditUpdate, "alice", "req-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mockSQL.ExpectCommit()
+
+	_, err := r.Update(ctx, &Todo{Model: gorm.Model{ID: 1}, Title: "new", Version: 1})
+	require.NoError(t, err)
+	require.NoError(t, mockSQL.ExpectationsWereMet())
+}
+
+func TestAuditUnchangedUpdateIsSkipped(t *testing.T) {
+	r := NewTodoRepo(auditedDB(t))
+	mockSQL.MatchExpectationsInOrder(true)
+	defer mockSQL.MatchExpectationsInOrder(false)
+	rows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "same")
+	}
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectQuery(`SELECT \* FROM "todos"`).WillReturnRows(rows())
+	mockSQL.ExpectExec(`UPDATE "todos"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mockSQL.ExpectQuery(`SELECT \* FROM "todos"`).WillReturnRows(rows())
+	mockSQL.ExpectCommit()
+
+	err := r.ReleaseReminder(context.Background(), 1)
+	require.NoError(t, err)
+	require.NoError(t, mockSQL.ExpectationsWereMet())
+}
+
+func TestAuditHardDelete(t *testing.T) {
+	r := NewTodoRepo(auditedDB(t))
+	mockSQL.MatchExpectationsInOrder(true)
+	defer mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	// the row is found through the WHERE clause of the statement
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE id = $1 AND deleted_at IS NOT NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "gone"))
+	mockSQL.ExpectExec(`DELETE FROM "todos"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mockSQL.ExpectQuery(`SELECT \* FROM "todos"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`VALUES ($1,$2,$3,$4,$5,$6,$7,$8,(NULL))`)).
+		WithArgs(sqlmock.AnyArg(), tenant.Default, "todos", 1, AuditDelete, "", "", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mockSQL.ExpectCommit()
+
+	require.NoError(t, r.HardDelete(context.Background(), 1))
+	require.NoError(t, mockSQL.ExpectationsWereMet())
+}
+
+func TestAuditClaimDueRemindersWritesSnapshottedRows(t *testing.T) {
+	db, mock := isolatedDB(t)
+	require.NoError(t, db.Use(Auditor{}))
+	r := NewTodoRepo(db)
+	now := time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE id IN (SELECT "id" FROM "todos" WHERE (remind_at <= $1 AND reminded_at IS NULL AND done = $2) AND "todos"."deleted_at" IS NULL ORDER BY remind_at LIMIT 10 FOR UPDATE SKIP LOCKED) FOR UPDATE`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(2, 1).AddRow(1, 1))
+	// the subquery is evaluated again by the UPDATE, which only writes the
+	// snapshotted rows
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`UPDATE "todos" SET "reminded_at"=$1,"version"=version + 1,"updated_at"=$2 WHERE id IN (SELECT "id" FROM "todos" WHERE (remind_at <= $3 AND reminded_at IS NULL AND done = $4) AND "todos"."deleted_at" IS NULL ORDER BY remind_at LIMIT 10 FOR UPDATE SKIP LOCKED) AND "todos"."id" IN ($5,$6) AND "todos"."deleted_at" IS NULL RETURNING *`)).
+		WithArgs(now, sqlmock.AnyArg(), now, false, 1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 2).AddRow(2, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE "todos"."id" IN ($1,$2)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 2).AddRow(2, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectCommit()
+	todos, err := r.ClaimDueReminders(context.Background(), now, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(todos))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
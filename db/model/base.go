@@ -1,59 +1,215 @@
 package model
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/jackc/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
+// ErrVersionConflict is returned by Update when a versioned row was changed
+// by someone else since it was read.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrDuplicateKey is returned by Create and Update when the row would break
+// a unique index.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// uniqueViolation is the SQLSTATE of a unique index violation.
+const uniqueViolation = "23505"
+
+// versionColumn enables optimistic locking: Update only writes a model with
+// a field mapped to it when the stored version still matches, and every
+// write increments it.
+const versionColumn = "version"
+
+// bumpVersion increments versionColumn. Writes of versioned rows that do not
+// go through Update set it too, otherwise a model read before them would
+// still pass the version check and write their columns back.
+var bumpVersion = gorm.Expr(versionColumn + " + 1")
+
 type Base[T any] interface {
-	Create(t *T) (*T, error)
-	Update(t *T) (*T, error)
-	Delete(t *T) error
-	FindById(id any) (*T, error)
-	FindAllByIds(id []any) ([]*T, error)
+	Create(ctx context.Context, t *T) (*T, error)
+	CreateInBatches(ctx context.Context, ts []*T, batchSize int) ([]*T, error)
+	Update(ctx context.Context, t *T) (*T, error)
+	Delete(ctx context.Context, t *T) error
+	Restore(ctx context.Context, id any) (*T, error)
+	HardDelete(ctx context.Context, id any) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	FindDeleted(ctx context.Context) ([]*T, error)
+	FindById(ctx context.Context, id any) (*T, error)
+	FindAllByIds(ctx context.Context, id []any) ([]*T, error)
+	Paginate(ctx context.Context, req PageRequest) (*Page[T], error)
 }
 
 type base[T any] struct {
 	db *gorm.DB
 }
 
-func (b *base[T]) Create(t *T) (*T, error) {
-	if err := b.db.Create(t).Error; err != nil {
+func (b *base[T]) Create(ctx context.Context, t *T) (*T, error) {
+	if err := b.stamp(ctx, t); err != nil {
 		return nil, err
 	}
+	if err := b.conn(ctx).Create(t).Error; err != nil {
+		return nil, duplicateKey(err)
+	}
 	return t, nil
 }
 
-func (b *base[T]) Update(t *T) (*T, error) {
-	if err := b.db.Save(t).Error; err != nil {
+// CreateInBatches inserts ts with one INSERT per batchSize rows.
+func (b *base[T]) CreateInBatches(ctx context.Context, ts []*T, batchSize int) ([]*T, error) {
+	if err := b.stamp(ctx, ts...); err != nil {
+		return nil, err
+	}
+	if err := b.conn(ctx).CreateInBatches(ts, batchSize).Error; err != nil {
+		return nil, duplicateKey(err)
+	}
+	return ts, nil
+}
+
+// Update saves every field of t. Versioned models are written with a
+// conditional UPDATE on the version t was read at; ErrVersionConflict is
+// returned, and t left unchanged, when the row has moved on.
+func (b *base[T]) Update(ctx context.Context, t *T) (*T, error) {
+	if err := b.stamp(ctx, t); err != nil {
+		return nil, err
+	}
+	version, err := b.field(versionColumn)
+	if err != nil {
 		return nil, err
 	}
+	if version == nil {
+		if err := b.conn(ctx).Save(t).Error; err != nil {
+			return nil, duplicateKey(err)
+		}
+		return t, nil
+	}
+	rv := reflect.ValueOf(t)
+	v, _ := version.ValueOf(ctx, rv)
+	read, _ := v.(uint)
+	if err := version.Set(ctx, rv, read+1); err != nil {
+		return nil, err
+	}
+	res := b.conn(ctx).Model(t).Select("*").Where(versionColumn+" = ?", read).Updates(t)
+	if res.Error == nil && res.RowsAffected == 0 {
+		res.Error = ErrVersionConflict
+	}
+	if res.Error != nil {
+		_ = version.Set(ctx, rv, read)
+		return nil, duplicateKey(res.Error)
+	}
 	return t, nil
 }
 
-func (b *base[T]) Delete(t *T) error {
-	if err := b.db.Delete(t).Error; err != nil {
+func (b *base[T]) Delete(ctx context.Context, t *T) error {
+	if err := b.conn(ctx).Delete(t).Error; err != nil {
 		return err
 	}
 	return nil
 }
-func (b *base[T]) FindById(id any) (*T, error) {
+
+// Restore clears deleted_at on a soft-deleted row and returns it.
+// gorm.ErrRecordNotFound is returned when no deleted row matches id.
+func (b *base[T]) Restore(ctx context.Context, id any) (*T, error) {
 	var t T
-	if err := b.db.First(&t, id).Error; err != nil {
+	values := map[string]any{"deleted_at": nil}
+	if version, err := b.field(versionColumn); err != nil {
+		return nil, err
+	} else if version != nil {
+		values[versionColumn] = bumpVersion
+	}
+	res := b.conn(ctx).Unscoped().Model(&t).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(values)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return b.FindById(ctx, id)
+}
+
+// HardDelete permanently removes a soft-deleted row. Live rows are never
+// touched; gorm.ErrRecordNotFound is returned when no deleted row matches id.
+func (b *base[T]) HardDelete(ctx context.Context, id any) error {
+	res := b.conn(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Delete(new(T))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PurgeDeletedBefore permanently removes rows soft-deleted before the given
+// time and returns how many were removed.
+func (b *base[T]) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res := b.conn(ctx).Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Delete(new(T))
+	return res.RowsAffected, res.Error
+}
+
+// FindDeleted lists soft-deleted rows, most recently deleted first.
+func (b *base[T]) FindDeleted(ctx context.Context) ([]*T, error) {
+	var t []*T
+	if err := b.conn(ctx).Unscoped().Where("deleted_at IS NOT NULL").Order("deleted_at DESC").Find(&t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (b *base[T]) FindById(ctx context.Context, id any) (*T, error) {
+	var t T
+	if err := b.conn(ctx).First(&t, id).Error; err != nil {
 		return nil, err
 	}
 	return &t, nil
 }
 
-func (b *base[T]) FindAllByIds(id []any) ([]*T, error) {
+func (b *base[T]) FindAllByIds(ctx context.Context, id []any) ([]*T, error) {
 	var t []*T
 	if len(id) == 0 {
-		if err := b.db.Find(&t).Error; err != nil {
+		if err := b.conn(ctx).Find(&t).Error; err != nil {
 			return nil, err
 		}
 		return t, nil
 	}
-	if err := b.db.Where("id in (?)", id).Find(&t).Error; err != nil {
+	if err := b.conn(ctx).Where("id in (?)", id).Find(&t).Error; err != nil {
 		return nil, err
 	}
 	return t, nil
 }
+
+// field looks up the field of T mapped to column, nil when T has none.
+func (b *base[T]) field(column string) (*schema.Field, error) {
+	s, err := b.schema()
+	if err != nil {
+		return nil, err
+	}
+	return s.LookUpField(column), nil
+}
+
+// schema parses the gorm schema of T; parsed schemas are cached by gorm.
+func (b *base[T]) schema() (*schema.Schema, error) {
+	stmt := &gorm.Statement{DB: b.db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, err
+	}
+	return stmt.Schema, nil
+}
+
+// duplicateKey wraps a unique index violation reported by Postgres in
+// ErrDuplicateKey and returns any other error unchanged.
+func duplicateKey(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s", ErrDuplicateKey, pgErr.ConstraintName)
+	}
+	return err
+}
@@ -1,8 +1,10 @@
 package model
 
 import (
+	"context"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,10 +31,24 @@ func TestCreate(t *testing.T) {
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
 		).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
 	mockSQL.ExpectCommit()
-	res, err := b.Create(&Todo{
+	res, err := b.Create(context.Background(), &Todo{
 		Title: title,
 		Done:  true,
 	})
@@ -60,10 +76,25 @@ func TestUpdate(t *testing.T) {
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mockSQL.ExpectCommit()
-	res, err := b.Update(&Todo{
+	res, err := b.Update(context.Background(), &Todo{
 		Model: gorm.Model{
 			ID: uint(id),
 		},
@@ -91,7 +122,7 @@ func TestDelete(t *testing.T) {
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mockSQL.ExpectCommit()
-	err := b.Delete(&Todo{Model: gorm.Model{ID: uint(id)}})
+	err := b.Delete(context.Background(), &Todo{Model: gorm.Model{ID: uint(id)}})
 	require.NoError(t, err)
 }
 
@@ -112,7 +143,7 @@ func TestFindByID(t *testing.T) {
 			NewRows([]string{"id", "title", "done"}).
 			AddRow(id, title, done))
 	mockSQL.ExpectCommit()
-	todo, err := b.FindById(id)
+	todo, err := b.FindById(context.Background(), id)
 	require.NoError(t, err)
 	assert.Equal(t, title, todo.Title)
 	assert.Equal(t, done, todo.Done)
@@ -135,10 +166,177 @@ func TestList(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "done"}).
 			AddRow(id, title, done))
 	mockSQL.ExpectCommit()
-	todos, err := b.FindAllByIds([]any{id})
+	todos, err := b.FindAllByIds(context.Background(), []any{id})
 	require.NoError(t, err)
 	assert.Equal(t, 1, len(todos))
 	assert.Equal(t, title, todos[0].Title)
 	assert.Equal(t, done, todos[0].Done)
 	assert.Equal(t, uint(id), todos[0].ID)
 }
+
+func TestUpdateVersionConflict(t *testing.T) {
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectExec(regexp.QuoteMeta(
+		`"version"=$14,"project_id"=$15,"recurrence"=$16,"recurrence_tz"=$17,"recurrence_start"=$18,"recurred_at"=$19 WHERE version = $20 AND "todos"."deleted_at" IS NULL AND "id" = $21`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mockSQL.ExpectCommit()
+	todo := &Todo{Model: gorm.Model{ID: 1}, Title: "stale", Version: 3}
+	_, err := b.Update(context.Background(), todo)
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	assert.Equal(t, uint(3), todo.Version)
+}
+
+func TestRestore(t *testing.T) {
+	var (
+		id    = 1
+		title = "test title"
+	)
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "todos" SET "deleted_at"=$1,"version"=version + 1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mockSQL.ExpectCommit()
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).
+			AddRow(id, title))
+	todo, err := b.Restore(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, uint(id), todo.ID)
+	assert.Equal(t, title, todo.Title)
+}
+
+func TestPaginate(t *testing.T) {
+	var (
+		createdAt = time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+		after     = Cursor{CreatedAt: createdAt, ID: 1}
+	)
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE (created_at, id) > ($1, $2) AND "todos"."deleted_at" IS NULL ORDER BY created_at ASC,id ASC LIMIT 3`)).
+		WithArgs(after.CreatedAt, after.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "title"}).
+			AddRow(2, createdAt, "second").
+			AddRow(3, createdAt.Add(time.Second), "third").
+			AddRow(4, createdAt.Add(2*time.Second), "fourth"))
+	page, err := b.Paginate(context.Background(), PageRequest{First: 2, After: &after})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(page.Items))
+	assert.True(t, page.HasNextPage)
+	assert.True(t, page.HasPreviousPage)
+	assert.Equal(t, Cursor{CreatedAt: createdAt, ID: 2}, page.Cursors[0])
+	assert.Equal(t, Cursor{CreatedAt: createdAt.Add(time.Second), ID: 3}, page.Cursors[1])
+}
+
+func TestPaginateBackward(t *testing.T) {
+	createdAt := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE "todos"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT 3`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "title"}).
+			AddRow(2, createdAt.Add(time.Second), "second").
+			AddRow(1, createdAt, "first"))
+	page, err := b.Paginate(context.Background(), PageRequest{Last: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(page.Items))
+	assert.Equal(t, uint(1), page.Items[0].ID)
+	assert.Equal(t, uint(2), page.Items[1].ID)
+	assert.False(t, page.HasNextPage)
+	assert.False(t, page.HasPreviousPage)
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	c := Cursor{CreatedAt: time.Date(2022, 12, 1, 10, 30, 0, 123, time.UTC), ID: 42}
+	decoded, err := DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, c, decoded)
+
+	_, err = DecodeCursor("not a cursor")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestHardDelete(t *testing.T) {
+	id := 1
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "todos" WHERE id = $1 AND deleted_at IS NOT NULL`)).
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mockSQL.ExpectCommit()
+	require.NoError(t, b.HardDelete(context.Background(), id))
+}
+
+func TestPurgeDeletedBefore(t *testing.T) {
+	before := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "todos" WHERE deleted_at IS NOT NULL AND deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mockSQL.ExpectCommit()
+	n, err := b.PurgeDeletedBefore(context.Background(), before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+}
+
+func TestFindDeleted(t *testing.T) {
+	var (
+		id    = 1
+		title = "test title"
+	)
+	b := &base[Todo]{
+		db: gDB,
+	}
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "deleted_at"}).
+			AddRow(id, title, time.Now()))
+	todos, err := b.FindDeleted(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(todos))
+	assert.Equal(t, title, todos[0].Title)
+	assert.True(t, todos[0].DeletedAt.Valid)
+}
+
+func TestCreateInBatchesInTransaction(t *testing.T) {
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	// CreateInBatches nests its own transaction as a savepoint
+	mockSQL.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mockSQL.ExpectCommit()
+	var created []*Todo
+	err := r.Transaction(context.Background(), func(tx TodoRepo) error {
+		var err error
+		created, err = tx.CreateInBatches(context.Background(), []*Todo{{Title: "a"}, {Title: "b"}}, 100)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(created))
+	assert.Equal(t, uint(2), created[1].ID)
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+	"go-graph/db"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Comment is a message on a todo, optionally replying to another comment
+// of the same todo. Deleted comments are kept without their body so that
+// the replies to them stay threaded; DeletedAt is therefore a plain column
+// rather than a gorm soft delete.
+type Comment struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	TenantID  string    `json:"tenantId" gorm:"size:64;not null;default:default;index"`
+	TodoID    uint      `json:"todoId" gorm:"not null;index"`
+	Todo      *Todo     `json:"todo,omitempty"`
+	AuthorID  uint      `json:"authorId" gorm:"not null;index"`
+	Author    *User     `json:"author,omitempty"`
+	ParentID  *uint     `json:"parentId" gorm:"index"`
+	Parent    *Comment  `json:"parent,omitempty"`
+	Body      string    `json:"body"`
+	// EditedAt is set whenever the author changes the body.
+	EditedAt  *time.Time `json:"editedAt"`
+	DeletedAt *time.Time `json:"deletedAt"`
+}
+
+type CommentRepo interface {
+	Base[Comment]
+	FindFirstByTodoIds(ctx context.Context, todoIDs []uint, limit int) ([]*Comment, error)
+}
+
+type commentRepo struct {
+	base[Comment]
+}
+
+func NewDefaultCommentRepo() CommentRepo {
+	return NewCommentRepo(db.GetConnection())
+}
+
+func NewCommentRepo(db *gorm.DB) CommentRepo {
+	return &commentRepo{base: base[Comment]{db: db}}
+}
+
+// FindFirstByTodoIds returns up to limit comments of every given todo in
+// the (created_at, id) order of Paginate, grouped by todo.
+func (r *commentRepo) FindFirstByTodoIds(ctx context.Context, todoIDs []uint, limit int) ([]*Comment, error) {
+	ranked := r.conn(ctx).Model(&Comment{}).
+		Select("comments.*, row_number() OVER (PARTITION BY todo_id ORDER BY created_at, id) AS position").
+		Where("todo_id IN ?", todoIDs)
+	var c []*Comment
+	err := r.db.WithContext(ctx).Table("(?) AS comments", ranked).
+		Where("position <= ?", limit).
+		Order("todo_id").Order("created_at").Order("id").
+		Find(&c).Error
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestPaginateComments(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewCommentRepo(db)
+	// deleted comments are listed too so that their replies stay threaded
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "comments" WHERE "todo_id" = $1 ORDER BY created_at ASC,id ASC LIMIT 3`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "todo_id", "body", "deleted_at"}).
+			AddRow(1, 1, "", time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)).
+			AddRow(2, 1, "reply", nil))
+	page, err := r.Paginate(context.Background(), PageRequest{First: 2, Where: map[string]any{"todo_id": 1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(page.Items))
+	assert.NotNil(t, page.Items[0].DeletedAt)
+	assert.Nil(t, page.Items[1].DeletedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindFirstByTodoIds(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewCommentRepo(db)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM (SELECT comments.*, row_number() OVER (PARTITION BY todo_id ORDER BY created_at, id) AS position FROM "comments" WHERE todo_id IN ($1,$2)) AS comments WHERE position <= $3 ORDER BY todo_id,created_at,id`)).
+		WithArgs(1, 2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "todo_id"}).AddRow(1, 1).AddRow(2, 2))
+	res, err := r.FindFirstByTodoIds(context.Background(), []uint{1, 2}, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of a row in the (created_at, id) keyset.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uint
+}
+
+// Encode returns the opaque string form of the cursor.
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixNano(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode.
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	i, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{CreatedAt: time.Unix(0, n).UTC(), ID: uint(i)}, nil
+}
+
+// PageRequest selects a window of rows. First/After page forward and
+// Last/Before page backward; exactly one of First and Last must be positive.
+// Where restricts the rows by column equality and Scopes narrow them
+// further.
+type PageRequest struct {
+	First  int
+	After  *Cursor
+	Last   int
+	Before *Cursor
+	Where  map[string]any
+	Scopes []func(*gorm.DB) *gorm.DB
+}
+
+// Page is one window of rows in (created_at, id) order together with the
+// cursor of every row.
+type Page[T any] struct {
+	Items           []*T
+	Cursors         []Cursor
+	HasNextPage     bool
+	HasPreviousPage bool
+}
+
+func (b *base[T]) Paginate(ctx context.Context, req PageRequest) (*Page[T], error) {
+	backward := req.Last > 0
+	limit := req.First
+	if backward {
+		limit = req.Last
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("page size must be positive, got %d", limit)
+	}
+
+	q := b.conn(ctx)
+	if len(req.Where) > 0 {
+		q = q.Where(req.Where)
+	}
+	q = q.Scopes(req.Scopes...)
+	if req.After != nil {
+		q = q.Where("(created_at, id) > (?, ?)", req.After.CreatedAt, req.After.ID)
+	}
+	if req.Before != nil {
+		q = q.Where("(created_at, id) < (?, ?)", req.Before.CreatedAt, req.Before.ID)
+	}
+	if backward {
+		q = q.Order("created_at DESC").Order("id DESC")
+	} else {
+		q = q.Order("created_at ASC").Order("id ASC")
+	}
+
+	var items []*T
+	if err := q.Limit(limit + 1).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	more := len(items) > limit
+	if more {
+		items = items[:limit]
+	}
+	page := &Page[T]{Items: items}
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		page.HasPreviousPage = more
+		page.HasNextPage = req.Before != nil
+	} else {
+		page.HasNextPage = more
+		page.HasPreviousPage = req.After != nil
+	}
+
+	cursors, err := b.cursors(items)
+	if err != nil {
+		return nil, err
+	}
+	page.Cursors = cursors
+	return page, nil
+}
+
+// cursors reads created_at and id of every item through the gorm schema of T,
+// so any model embedding gorm.Model can be paginated.
+func (b *base[T]) cursors(items []*T) ([]Cursor, error) {
+	s, err := b.schema()
+	if err != nil {
+		return nil, err
+	}
+	createdAt := s.LookUpField("created_at")
+	id := s.LookUpField("id")
+	if createdAt == nil || id == nil {
+		return nil, fmt.Errorf("%s has no created_at or id column", s.Name)
+	}
+	ctx := context.Background()
+	cursors := make([]Cursor, len(items))
+	for i, item := range items {
+		rv := reflect.ValueOf(item)
+		c, _ := createdAt.ValueOf(ctx, rv)
+		v, _ := id.ValueOf(ctx, rv)
+		t, ok := c.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("%s.created_at is not a time.Time", s.Name)
+		}
+		n, ok := v.(uint)
+		if !ok {
+			return nil, fmt.Errorf("%s.id is not a uint", s.Name)
+		}
+		cursors[i] = Cursor{CreatedAt: t, ID: n}
+	}
+	return cursors, nil
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+	"go-graph/db"
+
+	"gorm.io/gorm"
+)
+
+// Project groups todos into a list. Archived projects keep their todos but
+// hide them from the default listings.
+type Project struct {
+	gorm.Model
+	TenantID string `json:"tenantId" gorm:"size:64;not null;default:default;index"`
+	Name     string `json:"name"`
+	// Color is a #rrggbb hex color; empty when none was picked.
+	Color    string `json:"color"`
+	Archived bool   `json:"archived" gorm:"not null;default:false;index"`
+	// OwnerID is the user who created the project and may change it next to
+	// admins; nil for projects created by admins without a user.
+	OwnerID *uint  `json:"ownerId" gorm:"index"`
+	Owner   *User  `json:"owner,omitempty"`
+	Todos   []Todo `json:"todos"`
+}
+
+type ProjectRepo interface {
+	Base[Project]
+	FindAll(ctx context.Context, includeArchived bool) ([]*Project, error)
+}
+
+type projectRepo struct {
+	base[Project]
+}
+
+func NewDefaultProjectRepo() ProjectRepo {
+	return NewProjectRepo(db.GetConnection())
+}
+
+func NewProjectRepo(db *gorm.DB) ProjectRepo {
+	return &projectRepo{base: base[Project]{db: db}}
+}
+
+// FindAll lists the projects ordered by name, leaving out archived ones
+// unless includeArchived is set.
+func (r *projectRepo) FindAll(ctx context.Context, includeArchived bool) ([]*Project, error) {
+	q := r.conn(ctx)
+	if !includeArchived {
+		q = q.Where("archived = ?", false)
+	}
+	var p []*Project
+	if err := q.Order("name").Order("id").Find(&p).Error; err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// archivedProjectIds selects the ids of archived projects.
+const archivedProjectIds = `SELECT id FROM projects WHERE archived AND deleted_at IS NULL`
+
+// WithoutArchivedProjects leaves out todos of archived projects; todos
+// without a project are kept.
+func WithoutArchivedProjects(db *gorm.DB) *gorm.DB {
+	return db.Where("project_id IS NULL OR project_id NOT IN (" + archivedProjectIds + ")")
+}
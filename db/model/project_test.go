@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindAllProjects(t *testing.T) {
+	r := NewProjectRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "projects" WHERE archived = $1 AND "projects"."deleted_at" IS NULL ORDER BY name,id`)).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Home"))
+	projects, err := r.FindAll(context.Background(), false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(projects))
+	assert.Equal(t, "Home", projects[0].Name)
+}
+
+func TestFindFilteredByProject(t *testing.T) {
+	projectID := uint(3)
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	// asking for one project shows its todos even when it is archived
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE project_id = $1 AND "todos"."deleted_at" IS NULL ORDER BY "id"`)).
+		WithArgs(projectID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "project_id"}).AddRow(1, "task", projectID))
+	todos, err := r.FindFiltered(context.Background(), TodoFilter{ProjectID: &projectID}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(todos))
+	assert.Equal(t, projectID, *todos[0].ProjectID)
+}
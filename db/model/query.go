@@ -0,0 +1,45 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInvalidOrder = errors.New("invalid order column")
+
+// Order sorts query results by a single column. Column must be one of the
+// columns the repository allowlists; it is never interpolated into SQL
+// unchecked.
+type Order struct {
+	Column string
+	Desc   bool
+}
+
+// orderScope sorts by o and then by id so results stay stable between
+// calls. Columns missing from allowed are rejected with ErrInvalidOrder.
+func orderScope(o *Order, allowed map[string]struct{}) (func(*gorm.DB) *gorm.DB, error) {
+	if o == nil {
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}})
+		}, nil
+	}
+	if _, ok := allowed[o.Column]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidOrder, o.Column)
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: o.Column}, Desc: o.Desc})
+		if o.Column != "id" {
+			db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: o.Desc})
+		}
+		return db
+	}, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"go-graph/db"
+
+	"gorm.io/gorm"
+)
+
+// Tag names are unique per tenant among live tags, so the name of a deleted
+// tag can be used again.
+type Tag struct {
+	gorm.Model
+	TenantID string `json:"tenantId" gorm:"size:64;not null;default:default;uniqueIndex:idx_tags_tenant_live_name,where:deleted_at IS NULL"`
+	Name     string `json:"name" gorm:"uniqueIndex:idx_tags_tenant_live_name"`
+	Todos    []Todo `json:"todos" gorm:"many2many:todo_tags"`
+}
+
+// TodoTag is a tag together with the todo it was loaded for.
+type TodoTag struct {
+	Tag    `gorm:"embedded"`
+	TodoID uint
+}
+
+type TagRepo interface {
+	Base[Tag]
+	FindByName(ctx context.Context, name string) (*Tag, error)
+	FindOrCreateByName(ctx context.Context, name string) (*Tag, error)
+	FindByTodoIds(ctx context.Context, todoIDs []uint) ([]*TodoTag, error)
+}
+
+type tagRepo struct {
+	base[Tag]
+}
+
+func NewDefaultTagRepo() TagRepo {
+	return NewTagRepo(db.GetConnection())
+}
+
+func NewTagRepo(db *gorm.DB) TagRepo {
+	return &tagRepo{base: base[Tag]{db: db}}
+}
+
+func (r *tagRepo) FindByName(ctx context.Context, name string) (*Tag, error) {
+	var t Tag
+	if err := r.conn(ctx).Where("name = ?", name).First(&t).Error; err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// FindOrCreateByName returns the live tag called name, creating it when
+// there is none. A tag created concurrently under the same name is looked
+// up again rather than reported.
+func (r *tagRepo) FindOrCreateByName(ctx context.Context, name string) (*Tag, error) {
+	var t Tag
+	if err := r.stamp(ctx, &t); err != nil {
+		return nil, err
+	}
+	err := duplicateKey(r.conn(ctx).Where(Tag{Name: name}).FirstOrCreate(&t).Error)
+	if errors.Is(err, ErrDuplicateKey) {
+		return r.FindByName(ctx, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// FindByTodoIds loads the tags of several todos in one query, ordered by
+// tag name.
+func (r *tagRepo) FindByTodoIds(ctx context.Context, todoIDs []uint) ([]*TodoTag, error) {
+	var t []*TodoTag
+	err := r.conn(ctx).Model(&Tag{}).
+		Select("tags.*, todo_tags.todo_id").
+		Joins("JOIN todo_tags ON todo_tags.tag_id = tags.id").
+		Where("todo_tags.todo_id IN ?", todoIDs).
+		Order("tags.name").
+		Find(&t).Error
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/gorm"
+)
+
+func TestFindByTodoIds(t *testing.T) {
+	r := NewTagRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT tags.*, todo_tags.todo_id FROM "tags" JOIN todo_tags ON todo_tags.tag_id = tags.id WHERE todo_tags.todo_id IN ($1,$2) AND "tags"."deleted_at" IS NULL ORDER BY tags.name`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "todo_id"}).
+			AddRow(1, "urgent", 1).
+			AddRow(2, "work", 1).
+			AddRow(2, "work", 2))
+	tags, err := r.FindByTodoIds(context.Background(), []uint{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tags))
+	assert.Equal(t, "urgent", tags[0].Name)
+	assert.Equal(t, uint(1), tags[0].TodoID)
+	assert.Equal(t, uint(2), tags[2].TodoID)
+}
+
+func TestTagNamesAreUniqueAmongLiveTags(t *testing.T) {
+	stmt := &gorm.Statement{DB: gDB}
+	require.NoError(t, stmt.Parse(&Tag{}))
+	idx := stmt.Schema.LookIndex("idx_tags_tenant_live_name")
+	require.NotNil(t, idx)
+	assert.Equal(t, "UNIQUE", idx.Class)
+	assert.Equal(t, "deleted_at IS NULL", idx.Where)
+	require.Equal(t, 2, len(idx.Fields))
+}
+
+func TestRenameTagToTakenName(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTagRepo(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tags" SET`)).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_tags_tenant_live_name"})
+	mock.ExpectRollback()
+	_, err := r.Update(acme(), &Tag{Model: gorm.Model{ID: 1}, Name: "work"})
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindOrCreateByNameLosingRace(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTagRepo(db)
+	tagRows := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"id", "name"}) }
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).WillReturnRows(tagRows())
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "tags"`)).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+	// the tag created by the winner is returned
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).WillReturnRows(tagRows().AddRow(3, "work"))
+	tag, err := r.FindOrCreateByName(acme(), "work")
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), tag.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"go-graph/pkg/tenant"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tenantColumn partitions rows between tenants. Queries of base[T] and of
+// the repositories built on it only see rows of the tenant on the context,
+// and inserts are stamped with it, for every model with a field mapped to
+// the column. Contexts without a tenant, i.e. background jobs, see all rows.
+const tenantColumn = "tenant_id"
+
+// scoped returns db bound to ctx and restricted to the tenant of ctx.
+func scoped(ctx context.Context, db *gorm.DB) *gorm.DB {
+	db = db.WithContext(ctx)
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return db
+	}
+	return db.Where(clause.Eq{
+		Column: clause.Column{Table: clause.CurrentTable, Name: tenantColumn},
+		Value:  id,
+	})
+}
+
+// tenantFilter returns an extra condition for raw queries over table,
+// empty for contexts without a tenant.
+func tenantFilter(ctx context.Context, table string) (string, []any) {
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	return " AND " + table + "." + tenantColumn + " = ?", []any{id}
+}
+
+// conn returns the connection every query of b starts from: bound to ctx
+// and, when T is partitioned, restricted to the tenant of ctx.
+func (b *base[T]) conn(ctx context.Context) *gorm.DB {
+	if f, _ := b.field(tenantColumn); f == nil {
+		return b.db.WithContext(ctx)
+	}
+	return scoped(ctx, b.db)
+}
+
+// stamp sets the tenant field of ts to the tenant of ctx, overriding
+// whatever the caller put there.
+func (b *base[T]) stamp(ctx context.Context, ts ...*T) error {
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	f, err := b.field(tenantColumn)
+	if err != nil || f == nil {
+		return err
+	}
+	for _, t := range ts {
+		if err := f.Set(ctx, reflect.ValueOf(t), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
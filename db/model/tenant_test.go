@@ -0,0 +1,148 @@
+package model
+
+import (
+	"context"
+	"go-graph/pkg/tenant"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func acme() context.Context {
+	return tenant.WithID(context.Background(), "acme")
+}
+
+// isolatedDB opens a gorm connection on a mock of its own, so that the
+// expectations left over by tests on the shared mock do not interfere with
+// the exact statements checked here.
+func isolatedDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{})
+	require.NoError(t, err)
+	return db, mock
+}
+
+func TestTenantFindById(t *testing.T) {
+	db, mock := isolatedDB(t)
+	b := &base[Todo]{db: db}
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE "todos"."tenant_id" = $1 AND "todos"."id" = $2 AND "todos"."deleted_at" IS NULL ORDER BY "todos"."id" LIMIT 1`)).
+		WithArgs("acme", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "title"}).AddRow(1, "acme", "mine"))
+	todo, err := b.FindById(acme(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "mine", todo.Title)
+
+	// a row of another tenant is not found even by its primary key
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE "todos"."tenant_id" = $1 AND "todos"."id" = $2`)).
+		WithArgs("acme", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	_, err = b.FindById(acme(), 2)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantFindAllByIds(t *testing.T) {
+	db, mock := isolatedDB(t)
+	b := &base[Todo]{db: db}
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE "todos"."tenant_id" = $1 AND id in ($2,$3) AND "todos"."deleted_at" IS NULL`)).
+		WithArgs("acme", 1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(1, "acme"))
+	todos, err := b.FindAllByIds(acme(), []any{1, 2})
+	require.NoError(t, err)
+	assert.Len(t, todos, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantCreateIsStamped(t *testing.T) {
+	db, mock := isolatedDB(t)
+	b := &base[Todo]{db: db}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "todos"`)).
+		WithArgs(
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			"acme",
+			"forged", false, nil, nil, PriorityNone, nil, nil, nil, false, uint(1), nil, "", "", nil, nil,
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	// the tenant of the context wins over one set by the caller
+	todo, err := b.Create(acme(), &Todo{TenantID: "globex", Title: "forged"})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", todo.TenantID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantUpdateAndDelete(t *testing.T) {
+	db, mock := isolatedDB(t)
+	b := &base[Todo]{db: db}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`WHERE "todos"."tenant_id" = $20 AND version = $21 AND "todos"."deleted_at" IS NULL AND "id" = $22`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	// updating a row of another tenant touches nothing
+	_, err := b.Update(acme(), &Todo{Model: gorm.Model{ID: 2}, TenantID: "globex", Title: "hijack", Version: 1})
+	assert.ErrorIs(t, err, ErrVersionConflict)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "todos" SET "deleted_at"=$1 WHERE "todos"."tenant_id" = $2 AND "todos"."id" = $3 AND "todos"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), "acme", 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, b.Delete(acme(), &Todo{Model: gorm.Model{ID: 2}}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantFindLineage(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE id = $1 AND deleted_at IS NULL AND todos.tenant_id = $2`)).
+		WithArgs(3, "acme", "acme", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1))
+	ids, err := r.FindLineage(acme(), 3, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{3, 1}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantPaginate(t *testing.T) {
+	db, mock := isolatedDB(t)
+	b := &base[Todo]{db: db}
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE "todos"."tenant_id" = $1 AND "todos"."deleted_at" IS NULL`)).
+		WithArgs("acme").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+	_, err := b.Paginate(acme(), PageRequest{First: 2})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantAuditEventIsStamped(t *testing.T) {
+	db, mock := isolatedDB(t)
+	require.NoError(t, db.Use(Auditor{}))
+	r := NewTodoRepo(db)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE "todos"."id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "title"}).AddRow(1, "acme", "new"))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+		WithArgs(sqlmock.AnyArg(), "acme", "todos", 1, AuditCreate, "", "", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	_, err := r.Create(acme(), &Todo{Title: "new"})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
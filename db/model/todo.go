@@ -1,19 +1,145 @@
 package model
 
 import (
+	"context"
+	"fmt"
 	"go-graph/db"
+	"html"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Priority ranks todos; the zero value means no priority was set.
+type Priority int16
+
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
 )
 
 type Todo struct {
 	gorm.Model
-	Title string `json:"title"`
-	Done  bool   `json:"done"`
+	TenantID string     `json:"tenantId" gorm:"size:64;not null;default:default;index"`
+	Title    string     `json:"title"`
+	Done     bool       `json:"done"`
+	UserID   *uint      `json:"userId" gorm:"index"`
+	User     *User      `json:"user,omitempty"`
+	Tags     []Tag      `json:"tags" gorm:"many2many:todo_tags"`
+	DueAt    *time.Time `json:"dueAt" gorm:"index"`
+	Priority Priority   `json:"priority" gorm:"not null;default:0"`
+	RemindAt *time.Time `json:"remindAt" gorm:"index"`
+	// RemindedAt is set when the reminder is claimed for delivery so that it
+	// fires once even across restarts. Changing RemindAt clears it.
+	RemindedAt *time.Time `json:"remindedAt"`
+	ParentID   *uint      `json:"parentId" gorm:"index"`
+	Parent     *Todo      `json:"parent,omitempty"`
+	Children   []Todo     `json:"children,omitempty" gorm:"foreignKey:ParentID"`
+	// AutoComplete marks the todo done once all of its children are done.
+	AutoComplete bool `json:"autoComplete"`
+	// Version is incremented on every write and guards Update against lost
+	// updates.
+	Version   uint     `json:"version" gorm:"not null;default:1"`
+	ProjectID *uint    `json:"projectId" gorm:"index"`
+	Project   *Project `json:"project,omitempty"`
+	// Recurrence is an RRULE (RFC 5545) repeating the todo from
+	// RecurrenceStart in the time zone RecurrenceTZ; empty for one-off todos.
+	Recurrence      string     `json:"recurrence" gorm:"size:512;not null;default:''"`
+	RecurrenceTZ    string     `json:"recurrenceTz" gorm:"size:64;not null;default:''"`
+	RecurrenceStart *time.Time `json:"recurrenceStart"`
+	// RecurredAt is set once the next occurrence was spawned, or the series
+	// found to be over, so that each occurrence is spawned once.
+	RecurredAt *time.Time `json:"recurredAt"`
+}
+
+// ChildCount counts the direct children of a todo.
+type ChildCount struct {
+	ParentID  uint
+	Total     int
+	Completed int
+}
+
+// TodoFilter narrows FindFiltered results; nil and empty fields are ignored.
+// Todos of archived projects are left out unless IncludeArchived is set or
+// the filter asks for one project.
+type TodoFilter struct {
+	Done          *bool
+	TextContains  string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UserID        *uint
+	// TagsAny keeps todos carrying at least one of the tags, TagsAll those
+	// carrying every one of them.
+	TagsAny         []string
+	TagsAll         []string
+	ProjectID       *uint
+	IncludeArchived bool
+}
+
+// TodoSearchConfig is the Postgres text search configuration used both by the
+// generated todos.search_vector column and by Search queries.
+const TodoSearchConfig = "simple"
+
+// TodoSearchHit is a todo matched by Search together with its rank and a
+// highlighted snippet of the title.
+type TodoSearchHit struct {
+	Todo `gorm:"embedded"`
+	Rank float64
+	// Snippet is the HTML escaped title with the matched words wrapped in
+	// <mark></mark>, safe to render as HTML.
+	Snippet string
+}
+
+// ts_headline leaves the title unescaped, so it marks matches with control
+// characters that are stripped from the title beforehand and swapped for
+// <mark></mark> once the rest has been escaped.
+const (
+	snippetStart = "\x02"
+	snippetStop  = "\x03"
+)
+
+var snippetMarks = strings.NewReplacer(snippetStart, "<mark>", snippetStop, "</mark>")
+
+// highlight HTML escapes a ts_headline snippet and turns its match markers
+// into <mark> elements.
+func highlight(snippet string) string {
+	return snippetMarks.Replace(html.EscapeString(snippet))
+}
+
+// todoOrderColumns are the columns todos may be sorted by.
+var todoOrderColumns = map[string]struct{}{
+	"id":         {},
+	"created_at": {},
+	"updated_at": {},
+	"title":      {},
+	"done":       {},
+	"due_at":     {},
+	"priority":   {},
 }
 
 type TodoRepo interface {
 	Base[Todo]
+	FindFiltered(ctx context.Context, filter TodoFilter, order *Order) ([]*Todo, error)
+	Search(ctx context.Context, query string, userID *uint, limit, offset int) ([]*TodoSearchHit, error)
+	AddTags(ctx context.Context, todo *Todo, tags ...*Tag) error
+	RemoveTags(ctx context.Context, todo *Todo, tags ...*Tag) error
+	ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]*Todo, error)
+	ReleaseReminder(ctx context.Context, id uint) error
+	FindDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*Todo, error)
+	FindChildren(ctx context.Context, parentIDs []uint) ([]*Todo, error)
+	FindByProjectIds(ctx context.Context, projectIDs []uint, userID *uint) ([]*Todo, error)
+	CountChildren(ctx context.Context, parentIDs []uint, userID *uint) ([]*ChildCount, error)
+	FindLineage(ctx context.Context, id uint, limit int) ([]uint, error)
+	SubtreeDepth(ctx context.Context, id uint, limit int) (int, error)
+	FindTrashed(ctx context.Context, userID *uint) ([]*Todo, error)
+	FindTrashedById(ctx context.Context, id uint, userID *uint) (*Todo, error)
+	PurgeTodo(ctx context.Context, id uint) ([]string, error)
+	PurgeTodosDeletedBefore(ctx context.Context, before time.Time) (int64, []string, error)
+	Transaction(ctx context.Context, fn func(tx TodoRepo) error) error
 }
 
 type todoRepo struct {
@@ -27,3 +153,413 @@ func NewDefaultTodoRepo() TodoRepo {
 func NewTodoRepo(db *gorm.DB) TodoRepo {
 	return &todoRepo{base: base[Todo]{db: db}}
 }
+
+func (r *todoRepo) FindFiltered(ctx context.Context, filter TodoFilter, order *Order) ([]*Todo, error) {
+	sort, err := orderScope(order, todoOrderColumns)
+	if err != nil {
+		return nil, err
+	}
+	var t []*Todo
+	if err := r.conn(ctx).Scopes(filter.scope, sort).Find(&t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Search ranks todos matching the web-style query (quoted phrases, OR, -word)
+// with ts_rank over the GIN indexed search_vector column created by the
+// migration tool. userID limits the search to the todos of one owner; todos
+// of archived projects are left out.
+func (r *todoRepo) Search(ctx context.Context, query string, userID *uint, limit, offset int) ([]*TodoSearchHit, error) {
+	var hits []*TodoSearchHit
+	q := r.conn(ctx)
+	if userID != nil {
+		q = q.Where("todos.user_id = ?", *userID)
+	}
+	err := q.Model(&Todo{}).
+		Scopes(WithoutArchivedProjects).
+		Joins("CROSS JOIN websearch_to_tsquery(?, ?) AS query", TodoSearchConfig, query).
+		Select(
+			"todos.*, ts_rank(todos.search_vector, query) AS rank, ts_headline(?, translate(todos.title, ?, ''), query, ?) AS snippet",
+			TodoSearchConfig, snippetStart+snippetStop, "StartSel="+snippetStart+", StopSel="+snippetStop,
+		).
+		Where("todos.search_vector @@ query").
+		Order("rank DESC").Order("todos.id DESC").
+		Limit(limit).Offset(offset).
+		Find(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hits {
+		h.Snippet = highlight(h.Snippet)
+	}
+	return hits, nil
+}
+
+// AddTags labels todo with tags, which must exist. Like Update it bumps the
+// version of todo and is recorded in the audit log, unless todo carried all
+// of the tags already.
+func (r *todoRepo) AddTags(ctx context.Context, todo *Todo, tags ...*Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	rows := make([]map[string]any, len(tags))
+	for i, t := range tags {
+		rows[i] = map[string]any{"todo_id": todo.ID, "tag_id": t.ID}
+	}
+	err := r.writeTags(ctx, todo, func(tx *gorm.DB) *gorm.DB {
+		return tx.Table("todo_tags").Clauses(clause.OnConflict{DoNothing: true}).Create(rows)
+	})
+	if err != nil {
+		return err
+	}
+	linked := make(map[uint]bool, len(todo.Tags))
+	for _, t := range todo.Tags {
+		linked[t.ID] = true
+	}
+	for _, t := range tags {
+		if !linked[t.ID] {
+			linked[t.ID] = true
+			todo.Tags = append(todo.Tags, *t)
+		}
+	}
+	return nil
+}
+
+// RemoveTags takes tags off todo, bumping its version and recording the
+// change like AddTags.
+func (r *todoRepo) RemoveTags(ctx context.Context, todo *Todo, tags ...*Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	ids := make([]uint, len(tags))
+	removed := make(map[uint]bool, len(tags))
+	for i, t := range tags {
+		ids[i] = t.ID
+		removed[t.ID] = true
+	}
+	err := r.writeTags(ctx, todo, func(tx *gorm.DB) *gorm.DB {
+		return tx.Exec("DELETE FROM todo_tags WHERE todo_id = ? AND tag_id IN ?", todo.ID, ids)
+	})
+	if err != nil {
+		return err
+	}
+	kept := todo.Tags[:0]
+	for _, t := range todo.Tags {
+		if !removed[t.ID] {
+			kept = append(kept, t)
+		}
+	}
+	todo.Tags = kept
+	return nil
+}
+
+// writeTags runs change on the todo_tags table and, when it changed any link,
+// bumps the version todo was read at in the same transaction.
+// ErrVersionConflict is returned when the todo has moved on.
+func (r *todoRepo) writeTags(ctx context.Context, todo *Todo, change func(tx *gorm.DB) *gorm.DB) error {
+	var (
+		now     = r.db.NowFunc()
+		changed bool
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return auditAssociation(tx, todo, todo.ID, "Tags", func() error {
+			res := change(tx)
+			if res.Error != nil || res.RowsAffected == 0 {
+				return res.Error
+			}
+			res = tx.Exec("UPDATE todos SET "+versionColumn+" = ?, updated_at = ? WHERE id = ? AND "+versionColumn+" = ?",
+				bumpVersion, now, todo.ID, todo.Version)
+			if res.Error == nil && res.RowsAffected == 0 {
+				res.Error = ErrVersionConflict
+			}
+			changed = res.Error == nil
+			return res.Error
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if changed {
+		todo.Version++
+		todo.UpdatedAt = now
+	}
+	return nil
+}
+
+// ClaimDueReminders marks up to limit open todos whose reminder is due as
+// reminded and returns them. Rows are locked with SKIP LOCKED so concurrent
+// schedulers never claim the same reminder, and the claim bumps the version
+// so a write of a todo read before it cannot clear reminded_at again.
+func (r *todoRepo) ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]*Todo, error) {
+	due := r.conn(ctx).Model(&Todo{}).Select("id").
+		Where("remind_at <= ? AND reminded_at IS NULL AND done = ?", now, false).
+		Order("remind_at").Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+	var todos []*Todo
+	err := r.conn(ctx).Model(&todos).Clauses(clause.Returning{}).
+		Where("id IN (?)", due).
+		Updates(map[string]any{
+			"reminded_at": now,
+			versionColumn: bumpVersion,
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+	return todos, nil
+}
+
+// ReleaseReminder clears a claim so the reminder is picked up again, used
+// when delivering it failed.
+func (r *todoRepo) ReleaseReminder(ctx context.Context, id uint) error {
+	return r.conn(ctx).Model(&Todo{}).Where("id = ?", id).Updates(map[string]any{
+		"reminded_at": nil,
+		versionColumn: bumpVersion,
+	}).Error
+}
+
+// FindDueRecurrences returns recurring todos due at now whose next
+// occurrence was not spawned yet, earliest first.
+func (r *todoRepo) FindDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*Todo, error) {
+	var t []*Todo
+	err := r.conn(ctx).
+		Where("recurrence <> '' AND recurred_at IS NULL AND due_at <= ?", now).
+		Order("due_at").Order("id").Limit(limit).
+		Find(&t).Error
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Transaction runs fn with a repository bound to a single database
+// transaction, committed when fn returns nil and rolled back otherwise.
+func (r *todoRepo) Transaction(ctx context.Context, fn func(tx TodoRepo) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(NewTodoRepo(tx))
+	})
+}
+
+// FindTrashed lists trashed todos like FindDeleted; userID limits them to
+// the todos of one owner.
+func (r *todoRepo) FindTrashed(ctx context.Context, userID *uint) ([]*Todo, error) {
+	var t []*Todo
+	if err := r.trashed(ctx, userID).Order("deleted_at DESC").Find(&t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// FindTrashedById returns the trashed todo id. gorm.ErrRecordNotFound is
+// returned when there is none, or when userID is set and does not own it.
+func (r *todoRepo) FindTrashedById(ctx context.Context, id uint, userID *uint) (*Todo, error) {
+	var t Todo
+	if err := r.trashed(ctx, userID).Where("id = ?", id).Take(&t).Error; err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *todoRepo) trashed(ctx context.Context, userID *uint) *gorm.DB {
+	q := r.conn(ctx).Unscoped().Where("deleted_at IS NOT NULL")
+	if userID != nil {
+		q = q.Where("user_id = ?", *userID)
+	}
+	return q
+}
+
+// PurgeTodo permanently removes the trashed todo id like HardDelete, see
+// purge for what goes with it. gorm.ErrRecordNotFound is returned when no
+// trashed todo matches id.
+func (r *todoRepo) PurgeTodo(ctx context.Context, id uint) ([]string, error) {
+	n, keys, err := r.purge(ctx, "id = ?", id)
+	if err == nil && n == 0 {
+		err = gorm.ErrRecordNotFound
+	}
+	return keys, err
+}
+
+// PurgeTodosDeletedBefore permanently removes todos trashed before the given
+// time like PurgeDeletedBefore, see purge for what goes with them.
+func (r *todoRepo) PurgeTodosDeletedBefore(ctx context.Context, before time.Time) (int64, []string, error) {
+	return r.purge(ctx, "deleted_at < ?", before)
+}
+
+// purge permanently removes the trashed todos matching query in a single
+// transaction, together with their tag links, comments and attachments,
+// and detaches their children. It returns how many todos were removed and
+// the storage keys of the removed attachments, whose blobs are left to the
+// caller.
+func (r *todoRepo) purge(ctx context.Context, query string, args ...any) (int64, []string, error) {
+	var (
+		n    int64
+		keys []string
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uint
+		// the lock keeps the todos from being restored meanwhile
+		err := scoped(ctx, tx).Unscoped().Model(&Todo{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("deleted_at IS NOT NULL").
+			Where(query, args...).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		err = tx.Unscoped().Model(&Todo{}).Where("parent_id IN ?", ids).Updates(map[string]any{
+			"parent_id":   nil,
+			versionColumn: bumpVersion,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&Attachment{}).Where("todo_id IN ?", ids).Pluck("storage_key", &keys).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("todo_id IN ?", ids).Delete(&Attachment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("todo_id IN ?", ids).Delete(&Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM todo_tags WHERE todo_id IN ?", ids).Error; err != nil {
+			return err
+		}
+		res := tx.Unscoped().Where("id IN ?", ids).Delete(&Todo{})
+		n = res.RowsAffected
+		return res.Error
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return n, keys, nil
+}
+
+// FindByProjectIds returns the todos of the given projects in creation
+// order; userID limits them to the todos of one owner.
+func (r *todoRepo) FindByProjectIds(ctx context.Context, projectIDs []uint, userID *uint) ([]*Todo, error) {
+	var t []*Todo
+	q := r.conn(ctx).Where("project_id IN ?", projectIDs)
+	if userID != nil {
+		q = q.Where("user_id = ?", *userID)
+	}
+	if err := q.Order("id").Find(&t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// FindChildren returns the direct children of the given todos in creation
+// order.
+func (r *todoRepo) FindChildren(ctx context.Context, parentIDs []uint) ([]*Todo, error) {
+	var t []*Todo
+	if err := r.conn(ctx).Where("parent_id IN ?", parentIDs).Order("id").Find(&t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// CountChildren counts the direct and completed children of the given todos,
+// only those of userID when it is set. Todos without children are left out.
+func (r *todoRepo) CountChildren(ctx context.Context, parentIDs []uint, userID *uint) ([]*ChildCount, error) {
+	var counts []*ChildCount
+	q := r.conn(ctx)
+	if userID != nil {
+		q = q.Where("user_id = ?", *userID)
+	}
+	err := q.Model(&Todo{}).
+		Select("parent_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE done) AS completed").
+		Where("parent_id IN ?", parentIDs).
+		Group("parent_id").
+		Find(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// todoLineage walks up from a todo through its parents, at most ? levels.
+// %[1]s takes the tenant filter of both steps.
+const todoLineage = `WITH RECURSIVE lineage AS (
+	SELECT id, parent_id, 1 AS depth FROM todos WHERE id = ? AND deleted_at IS NULL%[1]s
+	UNION ALL
+	SELECT todos.id, todos.parent_id, lineage.depth + 1 FROM todos
+	JOIN lineage ON todos.id = lineage.parent_id
+	WHERE todos.deleted_at IS NULL%[1]s AND lineage.depth < ?
+) SELECT id FROM lineage ORDER BY depth`
+
+// FindLineage returns id followed by the ids of its ancestors, nearest
+// first, stopping after limit entries so corrupt data cannot loop forever.
+func (r *todoRepo) FindLineage(ctx context.Context, id uint, limit int) ([]uint, error) {
+	var ids []uint
+	query, args := recursive(ctx, todoLineage, id, limit)
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// todoSubtree walks down from a todo through its children, at most ? levels.
+// %[1]s takes the tenant filter of both steps.
+const todoSubtree = `WITH RECURSIVE subtree AS (
+	SELECT id, 0 AS depth FROM todos WHERE id = ?%[1]s
+	UNION ALL
+	SELECT todos.id, subtree.depth + 1 FROM todos
+	JOIN subtree ON todos.parent_id = subtree.id
+	WHERE todos.deleted_at IS NULL%[1]s AND subtree.depth < ?
+) SELECT COALESCE(MAX(depth), 0) FROM subtree`
+
+// SubtreeDepth returns how many levels of descendants a todo has, 0 for a
+// todo without children, counting at most limit levels.
+func (r *todoRepo) SubtreeDepth(ctx context.Context, id uint, limit int) (int, error) {
+	var depth int
+	query, args := recursive(ctx, todoSubtree, id, limit)
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&depth).Error; err != nil {
+		return 0, err
+	}
+	return depth, nil
+}
+
+// recursive fills the tenant filter into the anchor and the recursive step
+// of a query walking the todo tree from id.
+func recursive(ctx context.Context, query string, id uint, limit int) (string, []any) {
+	cond, tenant := tenantFilter(ctx, "todos")
+	args := append([]any{id}, tenant...)
+	args = append(args, tenant...)
+	return fmt.Sprintf(query, cond), append(args, limit)
+}
+
+// taggedTodoIds selects the ids of todos carrying any of the named tags.
+const taggedTodoIds = `SELECT todo_tags.todo_id FROM todo_tags
+	JOIN tags ON tags.id = todo_tags.tag_id AND tags.deleted_at IS NULL
+	WHERE tags.name IN ?`
+
+func (f TodoFilter) scope(db *gorm.DB) *gorm.DB {
+	if f.Done != nil {
+		db = db.Where("done = ?", *f.Done)
+	}
+	if f.TextContains != "" {
+		db = db.Where(`title ILIKE ? ESCAPE '\'`, "%"+escapeLike(f.TextContains)+"%")
+	}
+	if f.CreatedAfter != nil {
+		db = db.Where("created_at >= ?", *f.CreatedAfter)
+	}
+	if f.CreatedBefore != nil {
+		db = db.Where("created_at < ?", *f.CreatedBefore)
+	}
+	if f.UserID != nil {
+		db = db.Where("user_id = ?", *f.UserID)
+	}
+	if len(f.TagsAny) > 0 {
+		db = db.Where("id IN ("+taggedTodoIds+")", f.TagsAny)
+	}
+	if len(f.TagsAll) > 0 {
+		db = db.Where("id IN ("+taggedTodoIds+" GROUP BY todo_tags.todo_id HAVING COUNT(DISTINCT tags.name) = ?)", f.TagsAll, len(f.TagsAll))
+	}
+	if f.ProjectID != nil {
+		db = db.Where("project_id = ?", *f.ProjectID)
+	} else if !f.IncludeArchived {
+		db = WithoutArchivedProjects(db)
+	}
+	return db
+}
@@ -1 +1,323 @@
 package model
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/gorm"
+)
+
+func TestFindFiltered(t *testing.T) {
+	var (
+		id     = 1
+		userID = uint(2)
+		done   = false
+		after  = time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+		title  = "buy 100% milk"
+	)
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE done = $1 AND title ILIKE $2 ESCAPE '\' AND created_at >= $3 AND user_id = $4 AND (project_id IS NULL OR project_id NOT IN (SELECT id FROM projects WHERE archived AND deleted_at IS NULL)) AND "todos"."deleted_at" IS NULL ORDER BY "title" DESC,"id" DESC`)).
+		WithArgs(done, `%100\%%`, after, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "user_id"}).
+			AddRow(id, title, userID))
+	todos, err := r.FindFiltered(context.Background(), TodoFilter{
+		Done:         &done,
+		TextContains: "100%",
+		CreatedAfter: &after,
+		UserID:       &userID,
+	}, &Order{Column: "title", Desc: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(todos))
+	require.NotNil(t, todos[0].UserID)
+	assert.Equal(t, userID, *todos[0].UserID)
+}
+
+func TestFindFilteredInvalidOrder(t *testing.T) {
+	r := NewTodoRepo(gDB)
+	_, err := r.FindFiltered(context.Background(), TodoFilter{}, &Order{Column: "title; DROP TABLE todos"})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+}
+
+func TestSearch(t *testing.T) {
+	var (
+		id      = 1
+		title   = "buy milk <img src=x onerror=alert(1)>"
+		snippet = "buy \x02milk\x03 <img src=x onerror=alert(1)>"
+	)
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT todos.*, ts_rank(todos.search_vector, query) AS rank, ts_headline($1, translate(todos.title, $2, ''), query, $3) AS snippet FROM "todos" CROSS JOIN websearch_to_tsquery($4, $5) AS query WHERE todos.search_vector @@ query AND (project_id IS NULL OR project_id NOT IN (SELECT id FROM projects WHERE archived AND deleted_at IS NULL)) AND "todos"."deleted_at" IS NULL ORDER BY rank DESC,todos.id DESC LIMIT 10 OFFSET 20`)).
+		WithArgs(TodoSearchConfig, "\x02\x03", "StartSel=\x02, StopSel=\x03", TodoSearchConfig, "milk").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "rank", "snippet"}).
+			AddRow(id, title, 0.5, snippet))
+	hits, err := r.Search(context.Background(), "milk", nil, 10, 20)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(hits))
+	assert.Equal(t, uint(id), hits[0].ID)
+	assert.Equal(t, title, hits[0].Title)
+	assert.Equal(t, 0.5, hits[0].Rank)
+	assert.Equal(t, "buy <mark>milk</mark> &lt;img src=x onerror=alert(1)&gt;", hits[0].Snippet)
+}
+
+func TestFindFilteredByTags(t *testing.T) {
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE (id IN (SELECT todo_tags.todo_id FROM todo_tags JOIN tags ON tags.id = todo_tags.tag_id AND tags.deleted_at IS NULL WHERE tags.name IN ($1,$2))) AND (id IN (SELECT todo_tags.todo_id FROM todo_tags JOIN tags ON tags.id = todo_tags.tag_id AND tags.deleted_at IS NULL WHERE tags.name IN ($3,$4) GROUP BY todo_tags.todo_id HAVING COUNT(DISTINCT tags.name) = $5)) AND (project_id IS NULL OR project_id NOT IN (SELECT id FROM projects WHERE archived AND deleted_at IS NULL)) AND "todos"."deleted_at" IS NULL ORDER BY "id"`)).
+		WithArgs("home", "work", "urgent", "today", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "task"))
+	todos, err := r.FindFiltered(context.Background(), TodoFilter{
+		TagsAny: []string{"home", "work"},
+		TagsAll: []string{"urgent", "today"},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(todos))
+}
+
+func TestClaimDueReminders(t *testing.T) {
+	now := time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC)
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectBegin()
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`UPDATE "todos" SET "reminded_at"=$1,"version"=version + 1,"updated_at"=$2 WHERE id IN (SELECT "id" FROM "todos" WHERE (remind_at <= $3 AND reminded_at IS NULL AND done = $4) AND "todos"."deleted_at" IS NULL ORDER BY remind_at LIMIT 10 FOR UPDATE SKIP LOCKED) AND "todos"."deleted_at" IS NULL RETURNING *`)).
+		WithArgs(now, sqlmock.AnyArg(), now, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "remind_at", "reminded_at"}).
+			AddRow(1, "call mom", now, now))
+	mockSQL.ExpectCommit()
+	todos, err := r.ClaimDueReminders(context.Background(), now, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(todos))
+	assert.Equal(t, "call mom", todos[0].Title)
+	assert.Equal(t, now, *todos[0].RemindedAt)
+}
+
+func TestReleaseReminder(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "todos" SET "reminded_at"=$1,"version"=version + 1,"updated_at"=$2 WHERE id = $3 AND "todos"."deleted_at" IS NULL`)).
+		WithArgs(nil, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, r.ReleaseReminder(context.Background(), 1))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindDueRecurrences(t *testing.T) {
+	now := time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC)
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE (recurrence <> '' AND recurred_at IS NULL AND due_at <= $1) AND "todos"."deleted_at" IS NULL ORDER BY due_at,id LIMIT 10`)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "recurrence"}).AddRow(1, "chores", "FREQ=WEEKLY"))
+	todos, err := r.FindDueRecurrences(context.Background(), now, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(todos))
+	assert.Equal(t, "FREQ=WEEKLY", todos[0].Recurrence)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountChildren(t *testing.T) {
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(
+		`SELECT parent_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE done) AS completed FROM "todos" WHERE parent_id IN ($1,$2) AND "todos"."deleted_at" IS NULL GROUP BY "parent_id"`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id", "total", "completed"}).AddRow(1, 3, 2))
+	counts, err := r.CountChildren(context.Background(), []uint{1, 2}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(counts))
+	assert.Equal(t, ChildCount{ParentID: 1, Total: 3, Completed: 2}, *counts[0])
+}
+
+func TestCountChildrenOfOwner(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	owner := uint(7)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT parent_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE done) AS completed FROM "todos" WHERE user_id = $1 AND parent_id IN ($2) AND "todos"."deleted_at" IS NULL GROUP BY "parent_id"`)).
+		WithArgs(owner, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id", "total", "completed"}).AddRow(1, 1, 0))
+	_, err := r.CountChildren(context.Background(), []uint{1}, &owner)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindByProjectIds(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	owner := uint(7)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE project_id IN ($1,$2) AND user_id = $3 AND "todos"."deleted_at" IS NULL ORDER BY id`)).
+		WithArgs(1, 2, owner).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(4, 1))
+	todos, err := r.FindByProjectIds(context.Background(), []uint{1, 2}, &owner)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(todos))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindLineage(t *testing.T) {
+	r := NewTodoRepo(gDB)
+	mockSQL.MatchExpectationsInOrder(false)
+	mockSQL.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE lineage AS`)).
+		WithArgs(3, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(2).AddRow(1))
+	ids, err := r.FindLineage(context.Background(), 3, 6)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{3, 2, 1}, ids)
+}
+
+func TestFindTrashedById(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	owner := uint(7)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "todos" WHERE deleted_at IS NOT NULL AND user_id = $1 AND id = $2 LIMIT 1`)).
+		WithArgs(owner, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}))
+	_, err := r.FindTrashedById(context.Background(), 1, &owner)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurgeTodo(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT "id" FROM "todos" WHERE "todos"."tenant_id" = $1 AND deleted_at IS NOT NULL AND id = $2 FOR UPDATE`)).
+		WithArgs("acme", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "todos" SET "parent_id"=$1,"version"=version + 1,"updated_at"=$2 WHERE parent_id IN ($3)`)).
+		WithArgs(nil, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT "storage_key" FROM "attachments" WHERE todo_id IN ($1)`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"storage_key"}).AddRow("todos/1/a"))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "attachments" WHERE todo_id IN ($1)`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "comments" WHERE todo_id IN ($1)`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM todo_tags WHERE todo_id IN ($1)`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "todos" WHERE id IN ($1)`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	keys, err := r.PurgeTodo(acme(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todos/1/a"}, keys)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurgeTodoNotInTrash(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+	_, err := r.PurgeTodo(context.Background(), 1)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddTags(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	todo := &Todo{Model: gorm.Model{ID: 1}, Version: 3}
+	tagRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"todo_id", "tag_id"})
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE "todos"."id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todo_tags"`)).WillReturnRows(tagRows())
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "todo_tags" ("tag_id","todo_id") VALUES ($1,$2) ON CONFLICT DO NOTHING`)).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE todos SET version = version + 1, updated_at = $1 WHERE id = $2 AND version = $3`)).
+		WithArgs(sqlmock.AnyArg(), 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos" WHERE "todos"."id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 4))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todo_tags"`)).WillReturnRows(tagRows().AddRow(1, 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "work"))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "todos", 1, AuditUpdate, "", "", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	require.NoError(t, r.AddTags(context.Background(), todo, &Tag{Model: gorm.Model{ID: 5}, Name: "work"}))
+	assert.Equal(t, uint(4), todo.Version)
+	require.Equal(t, 1, len(todo.Tags))
+	assert.Equal(t, "work", todo.Tags[0].Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddTagsAlreadyLinked(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	todo := &Todo{Model: gorm.Model{ID: 1}, Version: 3}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todo_tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"todo_id", "tag_id"}).AddRow(1, 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "work"))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "todo_tags"`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todo_tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"todo_id", "tag_id"}).AddRow(1, 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "work"))
+	mock.ExpectCommit()
+	require.NoError(t, r.AddTags(context.Background(), todo, &Tag{Model: gorm.Model{ID: 5}, Name: "work"}))
+	assert.Equal(t, uint(3), todo.Version)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveTagsVersionConflict(t *testing.T) {
+	db, mock := isolatedDB(t)
+	r := NewTodoRepo(db)
+	todo := &Todo{Model: gorm.Model{ID: 1}, Version: 3, Tags: []Tag{{Model: gorm.Model{ID: 5}}}}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todos"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 4))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "todo_tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"todo_id", "tag_id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM todo_tags WHERE todo_id = $1 AND tag_id IN ($2)`)).
+		WithArgs(1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE todos SET version = version + 1`)).
+		WithArgs(sqlmock.AnyArg(), 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err := r.RemoveTags(context.Background(), todo, &Tag{Model: gorm.Model{ID: 5}})
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	assert.Equal(t, uint(3), todo.Version)
+	assert.Equal(t, 1, len(todo.Tags))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"go-graph/db"
+
+	"gorm.io/gorm"
+)
+
+type User struct {
+	gorm.Model
+	TenantID string `json:"tenantId" gorm:"size:64;not null;default:default;index"`
+	Name     string `json:"name"`
+	Todos    []Todo `json:"todos"`
+}
+
+type UserRepo interface {
+	Base[User]
+}
+
+type userRepo struct {
+	base[User]
+}
+
+func NewDefaultUserRepo() UserRepo {
+	return NewUserRepo(db.GetConnection())
+}
+
+func NewUserRepo(db *gorm.DB) UserRepo {
+	return &userRepo{base: base[User]{db: db}}
+}
@@ -0,0 +1,49 @@
+package directive
+
+import (
+	"context"
+	"fmt"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// HasRole implements @hasRole. The field is resolved only for callers
+// granted role.
+func HasRole(ctx context.Context, obj any, next graphql.Resolver, role modelgen.Role) (any, error) {
+	p, err := auth.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !p.HasRole(string(role)) {
+		return nil, auth.ErrForbidden
+	}
+	return next(ctx)
+}
+
+// IsOwner implements @isOwner. The field is resolved only for admins and the
+// user owning obj: the user itself or the owner of a todo.
+func IsOwner(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	p, err := auth.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.HasRole(auth.RoleAdmin) {
+		return next(ctx)
+	}
+	var owner *int
+	switch v := obj.(type) {
+	case *modelgen.User:
+		owner = &v.ID
+	case *modelgen.Todo:
+		owner = v.UserID
+	default:
+		return nil, apperr.Internal(fmt.Errorf("@isOwner does not support %T", obj))
+	}
+	if owner == nil || p.UserID == 0 || uint(*owner) != p.UserID {
+		return nil, auth.ErrForbidden
+	}
+	return next(ctx)
+}
@@ -0,0 +1,62 @@
+package directive
+
+import (
+	"context"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func as(p *auth.Principal) context.Context {
+	return auth.WithPrincipal(context.Background(), p)
+}
+
+func TestHasRole(t *testing.T) {
+	next := value("secret")
+	_, err := HasRole(context.Background(), nil, next, modelgen.RoleAdmin)
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+	_, err = HasRole(as(&auth.Principal{Subject: "1", Roles: []string{"USER"}}), nil, next, modelgen.RoleAdmin)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	res, err := HasRole(as(&auth.Principal{Subject: "1", Roles: []string{"ADMIN"}}), nil, next, modelgen.RoleAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", res)
+}
+
+func TestIsOwner(t *testing.T) {
+	var (
+		next  = value("secret")
+		owner = 1
+		alice = as(&auth.Principal{Subject: "1", UserID: 1})
+		bob   = as(&auth.Principal{Subject: "2", UserID: 2})
+		admin = as(&auth.Principal{Subject: "3", UserID: 3, Roles: []string{"ADMIN"}})
+	)
+	tests := []struct {
+		name string
+		ctx  context.Context
+		obj  any
+		err  error
+	}{
+		{"anonymous", context.Background(), &modelgen.User{ID: owner}, auth.ErrUnauthenticated},
+		{"own user", alice, &modelgen.User{ID: owner}, nil},
+		{"other user", bob, &modelgen.User{ID: owner}, auth.ErrForbidden},
+		{"own todo", alice, &modelgen.Todo{UserID: &owner}, nil},
+		{"other todo", bob, &modelgen.Todo{UserID: &owner}, auth.ErrForbidden},
+		{"unowned todo", alice, &modelgen.Todo{}, auth.ErrForbidden},
+		{"admin", admin, &modelgen.Todo{UserID: &owner}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := IsOwner(tt.ctx, tt.obj, next)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "secret", res)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Package directive implements the schema directives wired into
+// generated.DirectiveRoot.
+package directive
+
+import (
+	"context"
+	"fmt"
+	"go-graph/pkg/apperr"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Violation is one broken @constraint. Path is the full path of the
+// offending argument or input field, e.g. createTodo.input.text.
+type Violation struct {
+	Path    ast.Path `json:"path"`
+	Message string   `json:"message"`
+}
+
+// formats are the values accepted by @constraint(format:).
+var formats = map[string]func(string) bool{
+	"email": func(s string) bool {
+		addr, err := mail.ParseAddress(s)
+		return err == nil && addr.Address == s
+	},
+	"url": func(s string) bool {
+		u, err := url.ParseRequestURI(s)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	},
+	"uuid": regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`).MatchString,
+}
+
+// patterns caches the compiled @constraint(pattern:) expressions.
+var patterns sync.Map
+
+// Constraint implements @constraint. Lengths count characters of strings and
+// items of lists; min and max bound numbers. Null values are not checked.
+//
+// Under the Validation extension the violations of every argument are
+// collected and reported together before the resolver runs; without it the
+// first violating value fails the field straight away.
+func Constraint(ctx context.Context, obj any, next graphql.Resolver, minLength, maxLength *int, pattern *string, min, max *float64, format *string) (any, error) {
+	v, err := next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := check(v, minLength, maxLength, pattern, min, max, format)
+	if err != nil {
+		return nil, apperr.Internal(err)
+	}
+	if len(msgs) == 0 {
+		return v, nil
+	}
+	path := graphql.GetPath(ctx)
+	violations := make([]Violation, len(msgs))
+	for i, msg := range msgs {
+		violations[i] = Violation{Path: path, Message: msg}
+	}
+	if c := collectorFor(ctx); c != nil {
+		c.add(graphql.GetFieldContext(ctx), violations)
+		return v, nil
+	}
+	return nil, violationError(violations)
+}
+
+// check returns a message for every constraint v breaks. The error reports
+// a malformed directive rather than bad input.
+func check(v any, minLength, maxLength *int, pattern *string, min, max *float64, format *string) ([]string, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	var msgs []string
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.String, reflect.Slice:
+		n, unit := rv.Len(), "items"
+		if rv.Kind() == reflect.String {
+			n, unit = utf8.RuneCountInString(rv.String()), "characters"
+		}
+		if minLength != nil && n < *minLength {
+			msgs = append(msgs, fmt.Sprintf("must be at least %d %s long", *minLength, unit))
+		}
+		if maxLength != nil && n > *maxLength {
+			msgs = append(msgs, fmt.Sprintf("must be at most %d %s long", *maxLength, unit))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		n := rv.Convert(reflect.TypeOf(float64(0))).Float()
+		if min != nil && n < *min {
+			msgs = append(msgs, fmt.Sprintf("must be at least %v", *min))
+		}
+		if max != nil && n > *max {
+			msgs = append(msgs, fmt.Sprintf("must be at most %v", *max))
+		}
+	}
+	if rv.Kind() != reflect.String {
+		return msgs, nil
+	}
+	s := rv.String()
+	if pattern != nil {
+		re, err := compile(*pattern)
+		if err != nil {
+			return nil, err
+		}
+		if !re.MatchString(s) {
+			msgs = append(msgs, fmt.Sprintf("must match %s", *pattern))
+		}
+	}
+	if format != nil {
+		valid, ok := formats[*format]
+		if !ok {
+			return nil, fmt.Errorf("unknown @constraint format %q", *format)
+		}
+		if !valid(s) {
+			msgs = append(msgs, fmt.Sprintf("must be a valid %s", *format))
+		}
+	}
+	return msgs, nil
+}
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid @constraint pattern: %w", err)
+	}
+	patterns.Store(pattern, re)
+	return re, nil
+}
+
+// violationError reports violations as a single VALIDATION input error;
+// extensions.violations lists them.
+func violationError(violations []Violation) *gqlerror.Error {
+	msg := fmt.Sprintf("%s %s", violations[0].Path, violations[0].Message)
+	if n := len(violations); n > 1 {
+		msg = fmt.Sprintf("%s (+%d more)", msg, n-1)
+	}
+	return &gqlerror.Error{
+		Message: msg,
+		Extensions: map[string]any{
+			"code":       string(apperr.CodeValidation),
+			"violations": violations,
+		},
+	}
+}
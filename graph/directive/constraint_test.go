@@ -0,0 +1,105 @@
+package directive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func intPtr(i int) *int            { return &i }
+func floatPtr(f float64) *float64  { return &f }
+func stringPtr(s string) *string   { return &s }
+func value(v any) graphql.Resolver { return func(context.Context) (any, error) { return v, nil } }
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		msgs  []string
+		check func(v any) ([]string, error)
+	}{
+		{"short string", "", []string{"must be at least 1 characters long"}, func(v any) ([]string, error) {
+			return check(v, intPtr(1), intPtr(3), nil, nil, nil, nil)
+		}},
+		{"long string counts characters", "äöüß", []string{"must be at most 3 characters long"}, func(v any) ([]string, error) {
+			return check(v, intPtr(1), intPtr(3), nil, nil, nil, nil)
+		}},
+		{"string pointer", stringPtr("abc"), nil, func(v any) ([]string, error) {
+			return check(v, intPtr(1), intPtr(3), nil, nil, nil, nil)
+		}},
+		{"null is not checked", (*string)(nil), nil, func(v any) ([]string, error) {
+			return check(v, intPtr(1), nil, stringPtr("^a"), nil, nil, stringPtr("email"))
+		}},
+		{"list length", []int{1, 2}, []string{"must be at most 1 items long"}, func(v any) ([]string, error) {
+			return check(v, nil, intPtr(1), nil, nil, nil, nil)
+		}},
+		{"number bounds", 101, []string{"must be at most 100"}, func(v any) ([]string, error) {
+			return check(v, nil, nil, nil, floatPtr(0), floatPtr(100), nil)
+		}},
+		{"number pointer", intPtr(-1), []string{"must be at least 0"}, func(v any) ([]string, error) {
+			return check(v, nil, nil, nil, floatPtr(0), floatPtr(100), nil)
+		}},
+		{"every violation is reported", "x1", []string{"must be at least 3 characters long", "must match ^[a-z]+$"}, func(v any) ([]string, error) {
+			return check(v, intPtr(3), nil, stringPtr("^[a-z]+$"), nil, nil, nil)
+		}},
+		{"email", "not an address", []string{"must be a valid email"}, func(v any) ([]string, error) {
+			return check(v, nil, nil, nil, nil, nil, stringPtr("email"))
+		}},
+		{"url", "https://example.com/a", nil, func(v any) ([]string, error) {
+			return check(v, nil, nil, nil, nil, nil, stringPtr("url"))
+		}},
+		{"uuid", "4a3e5f3c-1b2d-4c5e-8f9a-0b1c2d3e4f5a", nil, func(v any) ([]string, error) {
+			return check(v, nil, nil, nil, nil, nil, stringPtr("uuid"))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := tt.check(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.msgs, msgs)
+		})
+	}
+}
+
+func TestCheckMalformedDirective(t *testing.T) {
+	_, err := check("a", nil, nil, stringPtr("("), nil, nil, nil)
+	assert.Error(t, err)
+	_, err = check("a", nil, nil, nil, nil, nil, stringPtr("phone"))
+	assert.Error(t, err)
+}
+
+func TestConstraintWithoutCollector(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("text"))
+	_, err := Constraint(ctx, nil, value(""), intPtr(1), nil, nil, nil, nil, nil)
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, "VALIDATION", gqlErr.Extensions["code"])
+	assert.Equal(t, "text must be at least 1 characters long", gqlErr.Message)
+}
+
+func TestConstraintCollectsViolations(t *testing.T) {
+	c := &collector{}
+	ctx := context.WithValue(context.Background(), collectorKey{}, c)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{})
+	for _, field := range []string{"text", "userId"} {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField(field))
+		v, err := Constraint(ctx, nil, value(""), intPtr(1), nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "", v)
+	}
+
+	_, err := Validation{}.InterceptField(ctx, func(context.Context) (any, error) {
+		t.Fatal("resolver must not run")
+		return nil, nil
+	})
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, "text must be at least 1 characters long (+1 more)", gqlErr.Message)
+	violations := gqlErr.Extensions["violations"].([]Violation)
+	require.Equal(t, 2, len(violations))
+	assert.Equal(t, "userId", violations[1].Path.String())
+}
@@ -0,0 +1,72 @@
+package directive
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Validation is a handler extension that lets @constraint collect every
+// violation of a field's arguments into one error. Arguments are decoded
+// before the field is resolved, so by the time InterceptField runs the
+// collector holds everything that was wrong with them.
+type Validation struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = Validation{}
+
+func (Validation) ExtensionName() string {
+	return "ConstraintValidation"
+}
+
+func (Validation) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Validation) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, collectorKey{}, &collector{}))
+}
+
+func (Validation) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	if c := collectorFor(ctx); c != nil {
+		if violations := c.take(graphql.GetFieldContext(ctx)); len(violations) > 0 {
+			return nil, violationError(violations)
+		}
+	}
+	return next(ctx)
+}
+
+type collectorKey struct{}
+
+// collector holds the violations of one operation by field. Fields of a
+// query resolve concurrently, hence the lock.
+type collector struct {
+	mu         sync.Mutex
+	violations map[*graphql.FieldContext][]Violation
+}
+
+func collectorFor(ctx context.Context) *collector {
+	c, _ := ctx.Value(collectorKey{}).(*collector)
+	return c
+}
+
+func (c *collector) add(fc *graphql.FieldContext, violations []Violation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.violations == nil {
+		c.violations = map[*graphql.FieldContext][]Violation{}
+	}
+	c.violations[fc] = append(c.violations[fc], violations...)
+}
+
+func (c *collector) take(fc *graphql.FieldContext) []Violation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	violations := c.violations[fc]
+	delete(c.violations, fc)
+	return violations
+}
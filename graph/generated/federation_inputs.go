@@ -0,0 +1,9 @@
+package generated
+
+// TodoByIDsInput is the representation passed to the multi entity resolver
+// of Todo. gqlgen v0.17.22 references entity resolver inputs unqualified
+// from federation.go, so the type must live in this package instead of
+// modelgen; gqlgen.yml binds it here.
+type TodoByIDsInput struct {
+	ID int `json:"id"`
+}
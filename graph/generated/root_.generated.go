@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"go-graph/graph/modelgen"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -30,28 +31,201 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Comment() CommentResolver
+	Entity() EntityResolver
 	Mutation() MutationResolver
+	Project() ProjectResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
+	Todo() TodoResolver
+	User() UserResolver
 }
 
 type DirectiveRoot struct {
+	Constraint func(ctx context.Context, obj interface{}, next graphql.Resolver, minLength *int, maxLength *int, pattern *string, min *float64, max *float64, format *string) (res interface{}, err error)
+	HasRole    func(ctx context.Context, obj interface{}, next graphql.Resolver, role modelgen.Role) (res interface{}, err error)
+	IsOwner    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	Attachment struct {
+		Checksum    func(childComplexity int) int
+		ContentType func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Filename    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Size        func(childComplexity int) int
+		TodoID      func(childComplexity int) int
+		URL         func(childComplexity int) int
+	}
+
+	AuditEvent struct {
+		Actor     func(childComplexity int) int
+		After     func(childComplexity int) int
+		Before    func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Entity    func(childComplexity int) int
+		EntityID  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Op        func(childComplexity int) int
+		RequestID func(childComplexity int) int
+	}
+
+	Comment struct {
+		Author    func(childComplexity int) int
+		AuthorID  func(childComplexity int) int
+		Body      func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		DeletedAt func(childComplexity int) int
+		EditedAt  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		ParentID  func(childComplexity int) int
+		TodoID    func(childComplexity int) int
+	}
+
+	CommentConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	CommentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	Entity struct {
+		FindManyTodoByIDs func(childComplexity int, reps []*TodoByIDsInput) int
+	}
+
 	Mutation struct {
-		CreateTodo func(childComplexity int, input modelgen.NewTodo) int
+		AddAttachment  func(childComplexity int, todoID int, file graphql.Upload) int
+		AddComment     func(childComplexity int, todoID int, body string, parentID *int) int
+		AddTag         func(childComplexity int, todoID int, name string) int
+		ArchiveProject func(childComplexity int, id int, archived bool) int
+		CreateProject  func(childComplexity int, input modelgen.NewProject) int
+		CreateTodo     func(childComplexity int, input modelgen.NewTodo) int
+		CreateTodos    func(childComplexity int, inputs []*modelgen.NewTodo) int
+		CreateUser     func(childComplexity int, input modelgen.NewUser) int
+		DeleteComment  func(childComplexity int, id int) int
+		DeleteTodo     func(childComplexity int, id int) int
+		DeleteTodos    func(childComplexity int, ids []int) int
+		EditComment    func(childComplexity int, id int, body string) int
+		MoveTodo       func(childComplexity int, id int, projectID *int, expectedVersion *int) int
+		PurgeTodo      func(childComplexity int, id int) int
+		RemoveTag      func(childComplexity int, todoID int, name string) int
+		RenameProject  func(childComplexity int, id int, name string) int
+		RenameTag      func(childComplexity int, id int, name string) int
+		RestoreTodo    func(childComplexity int, id int) int
+		SetTodoDone    func(childComplexity int, id int, done bool, expectedVersion *int) int
+		SetTodoParent  func(childComplexity int, id int, parentID *int, expectedVersion *int) int
+		UpdateTodo     func(childComplexity int, id int, input modelgen.UpdateTodo, expectedVersion *int) int
+		UpdateTodos    func(childComplexity int, inputs []*modelgen.TodoPatch) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
+	Project struct {
+		Archived  func(childComplexity int) int
+		Color     func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		OwnerID   func(childComplexity int) int
+		Todos     func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
 	}
 
 	Query struct {
+		AuditEvents        func(childComplexity int, filter *modelgen.AuditEventFilter, first *int) int
 		Gettodo            func(childComplexity int, id string) int
-		Todos              func(childComplexity int) int
+		Me                 func(childComplexity int) int
+		PreviewRecurrence  func(childComplexity int, recurrence string, start time.Time, timeZone *string, count int) int
+		Project            func(childComplexity int, id int) int
+		Projects           func(childComplexity int, includeArchived *bool) int
+		SearchTodos        func(childComplexity int, query string, first *int, after *string) int
+		Tags               func(childComplexity int) int
+		Todos              func(childComplexity int, userID *int, filter *modelgen.TodoFilter, orderBy *modelgen.TodoOrder) int
+		TodosConnection    func(childComplexity int, first *int, after *string, last *int, before *string) int
+		TrashedTodos       func(childComplexity int) int
+		User               func(childComplexity int, id int) int
+		Users              func(childComplexity int) int
 		__resolve__service func(childComplexity int) int
+		__resolve_entities func(childComplexity int, representations []map[string]interface{}) int
 	}
 
-	Todo struct {
-		Done func(childComplexity int) int
+	Subscription struct {
+		TodoChanged func(childComplexity int, userID *int) int
+	}
+
+	Tag struct {
 		ID   func(childComplexity int) int
-		Text func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
+	Todo struct {
+		Attachments        func(childComplexity int) int
+		AutoComplete       func(childComplexity int) int
+		Children           func(childComplexity int) int
+		Comments           func(childComplexity int, first *int, after *string) int
+		CompletedChildren  func(childComplexity int) int
+		CreatedAt          func(childComplexity int) int
+		Done               func(childComplexity int) int
+		DueAt              func(childComplexity int) int
+		History            func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		Parent             func(childComplexity int) int
+		ParentID           func(childComplexity int) int
+		Priority           func(childComplexity int) int
+		Project            func(childComplexity int) int
+		ProjectID          func(childComplexity int) int
+		Recurrence         func(childComplexity int) int
+		RecurrenceTimeZone func(childComplexity int) int
+		RemindAt           func(childComplexity int) int
+		Tags               func(childComplexity int) int
+		Text               func(childComplexity int) int
+		TotalChildren      func(childComplexity int) int
+		UpdatedAt          func(childComplexity int) int
+		User               func(childComplexity int) int
+		UserID             func(childComplexity int) int
+		Version            func(childComplexity int) int
+	}
+
+	TodoConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	TodoEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	TodoEvent struct {
+		Kind func(childComplexity int) int
+		Todo func(childComplexity int) int
+	}
+
+	TodoSearchConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	TodoSearchHit struct {
+		Cursor  func(childComplexity int) int
+		Node    func(childComplexity int) int
+		Rank    func(childComplexity int) int
+		Snippet func(childComplexity int) int
+	}
+
+	User struct {
+		ID    func(childComplexity int) int
+		Name  func(childComplexity int) int
+		Todos func(childComplexity int) int
 	}
 
 	_Service struct {
@@ -74,43 +248,803 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Mutation.createTodo":
-		if e.complexity.Mutation.CreateTodo == nil {
+	case "Attachment.checksum":
+		if e.complexity.Attachment.Checksum == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Checksum(childComplexity), true
+
+	case "Attachment.contentType":
+		if e.complexity.Attachment.ContentType == nil {
+			break
+		}
+
+		return e.complexity.Attachment.ContentType(childComplexity), true
+
+	case "Attachment.createdAt":
+		if e.complexity.Attachment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Attachment.CreatedAt(childComplexity), true
+
+	case "Attachment.filename":
+		if e.complexity.Attachment.Filename == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Filename(childComplexity), true
+
+	case "Attachment.id":
+		if e.complexity.Attachment.ID == nil {
+			break
+		}
+
+		return e.complexity.Attachment.ID(childComplexity), true
+
+	case "Attachment.size":
+		if e.complexity.Attachment.Size == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Size(childComplexity), true
+
+	case "Attachment.todoId":
+		if e.complexity.Attachment.TodoID == nil {
+			break
+		}
+
+		return e.complexity.Attachment.TodoID(childComplexity), true
+
+	case "Attachment.url":
+		if e.complexity.Attachment.URL == nil {
+			break
+		}
+
+		return e.complexity.Attachment.URL(childComplexity), true
+
+	case "AuditEvent.actor":
+		if e.complexity.AuditEvent.Actor == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Actor(childComplexity), true
+
+	case "AuditEvent.after":
+		if e.complexity.AuditEvent.After == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.After(childComplexity), true
+
+	case "AuditEvent.before":
+		if e.complexity.AuditEvent.Before == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Before(childComplexity), true
+
+	case "AuditEvent.createdAt":
+		if e.complexity.AuditEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.CreatedAt(childComplexity), true
+
+	case "AuditEvent.entity":
+		if e.complexity.AuditEvent.Entity == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Entity(childComplexity), true
+
+	case "AuditEvent.entityId":
+		if e.complexity.AuditEvent.EntityID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.EntityID(childComplexity), true
+
+	case "AuditEvent.id":
+		if e.complexity.AuditEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.ID(childComplexity), true
+
+	case "AuditEvent.op":
+		if e.complexity.AuditEvent.Op == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Op(childComplexity), true
+
+	case "AuditEvent.requestId":
+		if e.complexity.AuditEvent.RequestID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.RequestID(childComplexity), true
+
+	case "Comment.author":
+		if e.complexity.Comment.Author == nil {
+			break
+		}
+
+		return e.complexity.Comment.Author(childComplexity), true
+
+	case "Comment.authorId":
+		if e.complexity.Comment.AuthorID == nil {
+			break
+		}
+
+		return e.complexity.Comment.AuthorID(childComplexity), true
+
+	case "Comment.body":
+		if e.complexity.Comment.Body == nil {
+			break
+		}
+
+		return e.complexity.Comment.Body(childComplexity), true
+
+	case "Comment.createdAt":
+		if e.complexity.Comment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.CreatedAt(childComplexity), true
+
+	case "Comment.deletedAt":
+		if e.complexity.Comment.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.DeletedAt(childComplexity), true
+
+	case "Comment.editedAt":
+		if e.complexity.Comment.EditedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.EditedAt(childComplexity), true
+
+	case "Comment.id":
+		if e.complexity.Comment.ID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ID(childComplexity), true
+
+	case "Comment.parentId":
+		if e.complexity.Comment.ParentID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ParentID(childComplexity), true
+
+	case "Comment.todoId":
+		if e.complexity.Comment.TodoID == nil {
+			break
+		}
+
+		return e.complexity.Comment.TodoID(childComplexity), true
+
+	case "CommentConnection.edges":
+		if e.complexity.CommentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.Edges(childComplexity), true
+
+	case "CommentConnection.pageInfo":
+		if e.complexity.CommentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.PageInfo(childComplexity), true
+
+	case "CommentEdge.cursor":
+		if e.complexity.CommentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Cursor(childComplexity), true
+
+	case "CommentEdge.node":
+		if e.complexity.CommentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Node(childComplexity), true
+
+	case "Entity.findManyTodoByIDs":
+		if e.complexity.Entity.FindManyTodoByIDs == nil {
+			break
+		}
+
+		args, err := ec.field_Entity_findManyTodoByIDs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Entity.FindManyTodoByIDs(childComplexity, args["reps"].([]*TodoByIDsInput)), true
+
+	case "Mutation.addAttachment":
+		if e.complexity.Mutation.AddAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddAttachment(childComplexity, args["todoId"].(int), args["file"].(graphql.Upload)), true
+
+	case "Mutation.addComment":
+		if e.complexity.Mutation.AddComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addComment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddComment(childComplexity, args["todoId"].(int), args["body"].(string), args["parentId"].(*int)), true
+
+	case "Mutation.addTag":
+		if e.complexity.Mutation.AddTag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTag(childComplexity, args["todoId"].(int), args["name"].(string)), true
+
+	case "Mutation.archiveProject":
+		if e.complexity.Mutation.ArchiveProject == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_archiveProject_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ArchiveProject(childComplexity, args["id"].(int), args["archived"].(bool)), true
+
+	case "Mutation.createProject":
+		if e.complexity.Mutation.CreateProject == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createProject_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateProject(childComplexity, args["input"].(modelgen.NewProject)), true
+
+	case "Mutation.createTodo":
+		if e.complexity.Mutation.CreateTodo == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTodo(childComplexity, args["input"].(modelgen.NewTodo)), true
+
+	case "Mutation.createTodos":
+		if e.complexity.Mutation.CreateTodos == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTodos_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTodos(childComplexity, args["inputs"].([]*modelgen.NewTodo)), true
+
+	case "Mutation.createUser":
+		if e.complexity.Mutation.CreateUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateUser(childComplexity, args["input"].(modelgen.NewUser)), true
+
+	case "Mutation.deleteComment":
+		if e.complexity.Mutation.DeleteComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteComment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteComment(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteTodo":
+		if e.complexity.Mutation.DeleteTodo == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTodo(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteTodos":
+		if e.complexity.Mutation.DeleteTodos == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTodos_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTodos(childComplexity, args["ids"].([]int)), true
+
+	case "Mutation.editComment":
+		if e.complexity.Mutation.EditComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_editComment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.EditComment(childComplexity, args["id"].(int), args["body"].(string)), true
+
+	case "Mutation.moveTodo":
+		if e.complexity.Mutation.MoveTodo == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_moveTodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MoveTodo(childComplexity, args["id"].(int), args["projectId"].(*int), args["expectedVersion"].(*int)), true
+
+	case "Mutation.purgeTodo":
+		if e.complexity.Mutation.PurgeTodo == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeTodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeTodo(childComplexity, args["id"].(int)), true
+
+	case "Mutation.removeTag":
+		if e.complexity.Mutation.RemoveTag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveTag(childComplexity, args["todoId"].(int), args["name"].(string)), true
+
+	case "Mutation.renameProject":
+		if e.complexity.Mutation.RenameProject == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_renameProject_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RenameProject(childComplexity, args["id"].(int), args["name"].(string)), true
+
+	case "Mutation.renameTag":
+		if e.complexity.Mutation.RenameTag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_renameTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RenameTag(childComplexity, args["id"].(int), args["name"].(string)), true
+
+	case "Mutation.restoreTodo":
+		if e.complexity.Mutation.RestoreTodo == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreTodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreTodo(childComplexity, args["id"].(int)), true
+
+	case "Mutation.setTodoDone":
+		if e.complexity.Mutation.SetTodoDone == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setTodoDone_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetTodoDone(childComplexity, args["id"].(int), args["done"].(bool), args["expectedVersion"].(*int)), true
+
+	case "Mutation.setTodoParent":
+		if e.complexity.Mutation.SetTodoParent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setTodoParent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetTodoParent(childComplexity, args["id"].(int), args["parentId"].(*int), args["expectedVersion"].(*int)), true
+
+	case "Mutation.updateTodo":
+		if e.complexity.Mutation.UpdateTodo == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTodo(childComplexity, args["id"].(int), args["input"].(modelgen.UpdateTodo), args["expectedVersion"].(*int)), true
+
+	case "Mutation.updateTodos":
+		if e.complexity.Mutation.UpdateTodos == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTodos_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTodos(childComplexity, args["inputs"].([]*modelgen.TodoPatch)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Project.archived":
+		if e.complexity.Project.Archived == nil {
+			break
+		}
+
+		return e.complexity.Project.Archived(childComplexity), true
+
+	case "Project.color":
+		if e.complexity.Project.Color == nil {
+			break
+		}
+
+		return e.complexity.Project.Color(childComplexity), true
+
+	case "Project.createdAt":
+		if e.complexity.Project.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Project.CreatedAt(childComplexity), true
+
+	case "Project.id":
+		if e.complexity.Project.ID == nil {
+			break
+		}
+
+		return e.complexity.Project.ID(childComplexity), true
+
+	case "Project.name":
+		if e.complexity.Project.Name == nil {
+			break
+		}
+
+		return e.complexity.Project.Name(childComplexity), true
+
+	case "Project.ownerId":
+		if e.complexity.Project.OwnerID == nil {
+			break
+		}
+
+		return e.complexity.Project.OwnerID(childComplexity), true
+
+	case "Project.todos":
+		if e.complexity.Project.Todos == nil {
+			break
+		}
+
+		return e.complexity.Project.Todos(childComplexity), true
+
+	case "Project.updatedAt":
+		if e.complexity.Project.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Project.UpdatedAt(childComplexity), true
+
+	case "Query.auditEvents":
+		if e.complexity.Query.AuditEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditEvents(childComplexity, args["filter"].(*modelgen.AuditEventFilter), args["first"].(*int)), true
+
+	case "Query.gettodo":
+		if e.complexity.Query.Gettodo == nil {
+			break
+		}
+
+		args, err := ec.field_Query_gettodo_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Gettodo(childComplexity, args["id"].(string)), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
+
+		return e.complexity.Query.Me(childComplexity), true
+
+	case "Query.previewRecurrence":
+		if e.complexity.Query.PreviewRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_previewRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PreviewRecurrence(childComplexity, args["recurrence"].(string), args["start"].(time.Time), args["timeZone"].(*string), args["count"].(int)), true
+
+	case "Query.project":
+		if e.complexity.Query.Project == nil {
+			break
+		}
+
+		args, err := ec.field_Query_project_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Project(childComplexity, args["id"].(int)), true
+
+	case "Query.projects":
+		if e.complexity.Query.Projects == nil {
+			break
+		}
+
+		args, err := ec.field_Query_projects_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Projects(childComplexity, args["includeArchived"].(*bool)), true
+
+	case "Query.searchTodos":
+		if e.complexity.Query.SearchTodos == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchTodos_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchTodos(childComplexity, args["query"].(string), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.tags":
+		if e.complexity.Query.Tags == nil {
+			break
+		}
+
+		return e.complexity.Query.Tags(childComplexity), true
+
+	case "Query.todos":
+		if e.complexity.Query.Todos == nil {
+			break
+		}
+
+		args, err := ec.field_Query_todos_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Todos(childComplexity, args["userId"].(*int), args["filter"].(*modelgen.TodoFilter), args["orderBy"].(*modelgen.TodoOrder)), true
+
+	case "Query.todosConnection":
+		if e.complexity.Query.TodosConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_todosConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TodosConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "Query.trashedTodos":
+		if e.complexity.Query.TrashedTodos == nil {
+			break
+		}
+
+		return e.complexity.Query.TrashedTodos(childComplexity), true
+
+	case "Query.user":
+		if e.complexity.Query.User == nil {
+			break
+		}
+
+		args, err := ec.field_Query_user_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.User(childComplexity, args["id"].(int)), true
+
+	case "Query.users":
+		if e.complexity.Query.Users == nil {
+			break
+		}
+
+		return e.complexity.Query.Users(childComplexity), true
+
+	case "Query._service":
+		if e.complexity.Query.__resolve__service == nil {
+			break
+		}
+
+		return e.complexity.Query.__resolve__service(childComplexity), true
+
+	case "Query._entities":
+		if e.complexity.Query.__resolve_entities == nil {
+			break
+		}
+
+		args, err := ec.field_Query__entities_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]interface{})), true
+
+	case "Subscription.todoChanged":
+		if e.complexity.Subscription.TodoChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_todoChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.TodoChanged(childComplexity, args["userId"].(*int)), true
+
+	case "Tag.id":
+		if e.complexity.Tag.ID == nil {
+			break
+		}
+
+		return e.complexity.Tag.ID(childComplexity), true
+
+	case "Tag.name":
+		if e.complexity.Tag.Name == nil {
+			break
+		}
+
+		return e.complexity.Tag.Name(childComplexity), true
+
+	case "Todo.attachments":
+		if e.complexity.Todo.Attachments == nil {
+			break
+		}
+
+		return e.complexity.Todo.Attachments(childComplexity), true
+
+	case "Todo.autoComplete":
+		if e.complexity.Todo.AutoComplete == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createTodo_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Todo.AutoComplete(childComplexity), true
+
+	case "Todo.children":
+		if e.complexity.Todo.Children == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateTodo(childComplexity, args["input"].(modelgen.NewTodo)), true
+		return e.complexity.Todo.Children(childComplexity), true
 
-	case "Query.gettodo":
-		if e.complexity.Query.Gettodo == nil {
+	case "Todo.comments":
+		if e.complexity.Todo.Comments == nil {
 			break
 		}
 
-		args, err := ec.field_Query_gettodo_args(context.TODO(), rawArgs)
+		args, err := ec.field_Todo_comments_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Gettodo(childComplexity, args["id"].(string)), true
+		return e.complexity.Todo.Comments(childComplexity, args["first"].(*int), args["after"].(*string)), true
 
-	case "Query.todos":
-		if e.complexity.Query.Todos == nil {
+	case "Todo.completedChildren":
+		if e.complexity.Todo.CompletedChildren == nil {
 			break
 		}
 
-		return e.complexity.Query.Todos(childComplexity), true
+		return e.complexity.Todo.CompletedChildren(childComplexity), true
 
-	case "Query._service":
-		if e.complexity.Query.__resolve__service == nil {
+	case "Todo.createdAt":
+		if e.complexity.Todo.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Query.__resolve__service(childComplexity), true
+		return e.complexity.Todo.CreatedAt(childComplexity), true
 
 	case "Todo.done":
 		if e.complexity.Todo.Done == nil {
@@ -119,6 +1053,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Todo.Done(childComplexity), true
 
+	case "Todo.dueAt":
+		if e.complexity.Todo.DueAt == nil {
+			break
+		}
+
+		return e.complexity.Todo.DueAt(childComplexity), true
+
+	case "Todo.history":
+		if e.complexity.Todo.History == nil {
+			break
+		}
+
+		return e.complexity.Todo.History(childComplexity), true
+
 	case "Todo.id":
 		if e.complexity.Todo.ID == nil {
 			break
@@ -126,6 +1074,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Todo.ID(childComplexity), true
 
+	case "Todo.parent":
+		if e.complexity.Todo.Parent == nil {
+			break
+		}
+
+		return e.complexity.Todo.Parent(childComplexity), true
+
+	case "Todo.parentId":
+		if e.complexity.Todo.ParentID == nil {
+			break
+		}
+
+		return e.complexity.Todo.ParentID(childComplexity), true
+
+	case "Todo.priority":
+		if e.complexity.Todo.Priority == nil {
+			break
+		}
+
+		return e.complexity.Todo.Priority(childComplexity), true
+
+	case "Todo.project":
+		if e.complexity.Todo.Project == nil {
+			break
+		}
+
+		return e.complexity.Todo.Project(childComplexity), true
+
+	case "Todo.projectId":
+		if e.complexity.Todo.ProjectID == nil {
+			break
+		}
+
+		return e.complexity.Todo.ProjectID(childComplexity), true
+
+	case "Todo.recurrence":
+		if e.complexity.Todo.Recurrence == nil {
+			break
+		}
+
+		return e.complexity.Todo.Recurrence(childComplexity), true
+
+	case "Todo.recurrenceTimeZone":
+		if e.complexity.Todo.RecurrenceTimeZone == nil {
+			break
+		}
+
+		return e.complexity.Todo.RecurrenceTimeZone(childComplexity), true
+
+	case "Todo.remindAt":
+		if e.complexity.Todo.RemindAt == nil {
+			break
+		}
+
+		return e.complexity.Todo.RemindAt(childComplexity), true
+
+	case "Todo.tags":
+		if e.complexity.Todo.Tags == nil {
+			break
+		}
+
+		return e.complexity.Todo.Tags(childComplexity), true
+
 	case "Todo.text":
 		if e.complexity.Todo.Text == nil {
 			break
@@ -133,6 +1144,146 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Todo.Text(childComplexity), true
 
+	case "Todo.totalChildren":
+		if e.complexity.Todo.TotalChildren == nil {
+			break
+		}
+
+		return e.complexity.Todo.TotalChildren(childComplexity), true
+
+	case "Todo.updatedAt":
+		if e.complexity.Todo.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Todo.UpdatedAt(childComplexity), true
+
+	case "Todo.user":
+		if e.complexity.Todo.User == nil {
+			break
+		}
+
+		return e.complexity.Todo.User(childComplexity), true
+
+	case "Todo.userId":
+		if e.complexity.Todo.UserID == nil {
+			break
+		}
+
+		return e.complexity.Todo.UserID(childComplexity), true
+
+	case "Todo.version":
+		if e.complexity.Todo.Version == nil {
+			break
+		}
+
+		return e.complexity.Todo.Version(childComplexity), true
+
+	case "TodoConnection.edges":
+		if e.complexity.TodoConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.TodoConnection.Edges(childComplexity), true
+
+	case "TodoConnection.pageInfo":
+		if e.complexity.TodoConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.TodoConnection.PageInfo(childComplexity), true
+
+	case "TodoEdge.cursor":
+		if e.complexity.TodoEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.TodoEdge.Cursor(childComplexity), true
+
+	case "TodoEdge.node":
+		if e.complexity.TodoEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.TodoEdge.Node(childComplexity), true
+
+	case "TodoEvent.kind":
+		if e.complexity.TodoEvent.Kind == nil {
+			break
+		}
+
+		return e.complexity.TodoEvent.Kind(childComplexity), true
+
+	case "TodoEvent.todo":
+		if e.complexity.TodoEvent.Todo == nil {
+			break
+		}
+
+		return e.complexity.TodoEvent.Todo(childComplexity), true
+
+	case "TodoSearchConnection.edges":
+		if e.complexity.TodoSearchConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.TodoSearchConnection.Edges(childComplexity), true
+
+	case "TodoSearchConnection.pageInfo":
+		if e.complexity.TodoSearchConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.TodoSearchConnection.PageInfo(childComplexity), true
+
+	case "TodoSearchHit.cursor":
+		if e.complexity.TodoSearchHit.Cursor == nil {
+			break
+		}
+
+		return e.complexity.TodoSearchHit.Cursor(childComplexity), true
+
+	case "TodoSearchHit.node":
+		if e.complexity.TodoSearchHit.Node == nil {
+			break
+		}
+
+		return e.complexity.TodoSearchHit.Node(childComplexity), true
+
+	case "TodoSearchHit.rank":
+		if e.complexity.TodoSearchHit.Rank == nil {
+			break
+		}
+
+		return e.complexity.TodoSearchHit.Rank(childComplexity), true
+
+	case "TodoSearchHit.snippet":
+		if e.complexity.TodoSearchHit.Snippet == nil {
+			break
+		}
+
+		return e.complexity.TodoSearchHit.Snippet(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
+	case "User.name":
+		if e.complexity.User.Name == nil {
+			break
+		}
+
+		return e.complexity.User.Name(childComplexity), true
+
+	case "User.todos":
+		if e.complexity.User.Todos == nil {
+			break
+		}
+
+		return e.complexity.User.Todos(childComplexity), true
+
 	case "_Service.sdl":
 		if e.complexity._Service.SDL == nil {
 			break
@@ -148,7 +1299,15 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAuditEventFilter,
+		ec.unmarshalInputNewProject,
 		ec.unmarshalInputNewTodo,
+		ec.unmarshalInputNewUser,
+		ec.unmarshalInputTodoByIDsInput,
+		ec.unmarshalInputTodoFilter,
+		ec.unmarshalInputTodoOrder,
+		ec.unmarshalInputTodoPatch,
+		ec.unmarshalInputUpdateTodo,
 	)
 	first := true
 
@@ -179,6 +1338,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -209,30 +1385,413 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "../schema/attachment.gql", Input: `scalar Upload
+
+type Attachment {
+  id: Int!
+  todoId: Int!
+  filename: String!
+  "sniffed from the contents"
+  contentType: String!
+  "in bytes"
+  size: Int!
+  "hex encoded SHA-256 of the contents"
+  checksum: String!
+  createdAt: DateTime!
+  "a signed link to download the file; it expires after a while"
+  url: String!
+}
+
+extend type Mutation {
+  "uploads file with a GraphQL multipart request and attaches it to a todo"
+  addAttachment(todoId: Int!, file: Upload!): Attachment!
+}
+`, BuiltIn: false},
+	{Name: "../schema/audit.gql", Input: `"arbitrary JSON object"
+scalar Map
+
+enum AuditOp {
+  CREATE
+  UPDATE
+  DELETE
+}
+
+"One row written by one statement."
+type AuditEvent {
+  id: Int!
+  "table the row belongs to, e.g. todos"
+  entity: String!
+  entityId: Int!
+  op: AuditOp!
+  "who made the change; null for anonymous requests and background jobs"
+  actor: String
+  requestId: String
+  "the row before the write; null for creates"
+  before: Map
+  "the row after the write; null for permanent deletes"
+  after: Map
+  createdAt: DateTime!
+}
+
+input AuditEventFilter {
+  entity: String
+  entityId: Int
+  actor: String
+  op: AuditOp
+  after: DateTime
+  before: DateTime
+}
+
+extend type Query {
+  "latest matching audit events, newest first"
+  auditEvents(filter: AuditEventFilter, first: Int = 50 @constraint(min: 0, max: 500)): [AuditEvent!]! @hasRole(role: ADMIN)
+}
+`, BuiltIn: false},
+	{Name: "../schema/comment.gql", Input: `type Comment {
+  id: Int!
+  todoId: Int!
+  authorId: Int!
+  author: User
+  "the comment this one replies to; null for top-level comments"
+  parentId: Int
+  "empty once the comment is deleted"
+  body: String!
+  createdAt: DateTime!
+  "when the author last changed the body"
+  editedAt: DateTime
+  "deleted comments are kept without their body so that replies stay threaded"
+  deletedAt: DateTime
+}
+
+type CommentEdge {
+  node: Comment!
+  cursor: Cursor!
+}
+
+type CommentConnection {
+  edges: [CommentEdge!]!
+  pageInfo: PageInfo!
+}
+
+extend type Mutation {
+  "comments on a todo as the signed-in user, optionally replying to parentId"
+  addComment(todoId: Int!, body: String! @constraint(minLength: 1, maxLength: 5000), parentId: Int): Comment!
+  "only the author may edit a comment"
+  editComment(id: Int!, body: String! @constraint(minLength: 1, maxLength: 5000)): Comment!
+  "the author or an admin may delete a comment"
+  deleteComment(id: Int!): Comment!
+}
+`, BuiltIn: false},
+	{Name: "../schema/directives.gql", Input: `"""
+Rejects argument and input field values outside the given bounds. Lengths
+count characters of strings and items of lists; min and max bound numbers;
+format is one of email, url or uuid. Violations are reported as VALIDATION
+errors listing every broken constraint in extensions.violations.
+"""
+directive @constraint(
+  minLength: Int
+  maxLength: Int
+  pattern: String
+  min: Float
+  max: Float
+  format: String
+) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
+enum Role {
+  ADMIN
+  USER
+}
+
+"Restricts a field to callers granted role; admins are not implied."
+directive @hasRole(role: Role!) on FIELD_DEFINITION
+
+"""
+Restricts a field to the owner of the object it is selected on and to
+admins, e.g. the user of a todo.
+"""
+directive @isOwner on FIELD_DEFINITION
+`, BuiltIn: false},
+	{Name: "../schema/federation.gql", Input: `extend schema
+  @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@key"])
+
+directive @entityResolver(multi: Boolean) on OBJECT
+`, BuiltIn: false},
+	{Name: "../schema/pagination.gql", Input: `type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: Cursor
+  endCursor: Cursor
+}
+
+enum OrderDirection {
+  ASC
+  DESC
+}
+`, BuiltIn: false},
+	{Name: "../schema/project.gql", Input: `type Project {
+  id: Int!
+  name: String!
+  "#rrggbb hex color; null when none was picked"
+  color: String
+  "archived projects keep their todos but hide them from the default listings"
+  archived: Boolean!
+  "the user who created the project; only they and admins may change it"
+  ownerId: Int
+  todos: [Todo!]!
+  createdAt: DateTime!
+  updatedAt: DateTime!
+}
+
+input NewProject {
+  name: String! @constraint(minLength: 1, maxLength: 100)
+  color: String @constraint(pattern: "^#[0-9a-fA-F]{6}$")
+}
+
+extend type Query {
+  projects(includeArchived: Boolean = false): [Project!]!
+  project(id: Int!): Project!
+}
+
+extend type Mutation {
+  createProject(input: NewProject!): Project!
+  renameProject(id: Int!, name: String! @constraint(minLength: 1, maxLength: 100)): Project!
+  "archives or, with archived set to false, restores a project"
+  archiveProject(id: Int!, archived: Boolean! = true): Project!
+  "moves a todo into projectId, or out of any project when projectId is null"
+  moveTodo(id: Int!, projectId: Int, expectedVersion: Int): Todo!
+}
+`, BuiltIn: false},
+	{Name: "../schema/scalars.gql", Input: `"RFC3339 timestamp, e.g. 2022-12-01T10:30:00Z"
+scalar DateTime
+
+"opaque pagination cursor; only pass back values returned by the same connection"
+scalar Cursor
+`, BuiltIn: false},
+	{Name: "../schema/tag.gql", Input: `type Tag {
+  id: Int!
+  name: String!
+}
+
+extend type Query {
+  tags: [Tag!]!
+}
+
+extend type Mutation {
+  addTag(todoId: Int!, name: String! @constraint(minLength: 1, maxLength: 50)): Todo!
+  removeTag(todoId: Int!, name: String! @constraint(minLength: 1, maxLength: 50)): Todo!
+  "tags are shared by the whole tenant, so only admins may rename them"
+  renameTag(id: Int!, name: String! @constraint(minLength: 1, maxLength: 50)): Tag! @hasRole(role: ADMIN)
+}
+`, BuiltIn: false},
 	{Name: "../schema/todo.gql", Input: `# GraphQL schema example
 #
 # https://gqlgen.com/getting-started/
 
-type Todo {
+type Todo @key(fields: "id") @entityResolver(multi: true) {
   id: Int!
   text: String!
   done: Boolean!
+  userId: Int
+  user: User
+  tags: [Tag!]!
+  dueAt: DateTime
+  priority: TodoPriority!
+  "when the owner should be reminded; null once cleared"
+  remindAt: DateTime
+  parentId: Int
+  parent: Todo
+  "direct subtasks in creation order"
+  children: [Todo!]!
+  completedChildren: Int!
+  totalChildren: Int!
+  "whether the todo is marked done once all of its children are done"
+  autoComplete: Boolean!
+  "incremented on every write; pass it as expectedVersion to detect concurrent edits"
+  version: Int!
+  "every recorded write to the todo, oldest first"
+  history: [AuditEvent!]! @isOwner
+  projectId: Int
+  project: Project
+  "comments in the order they were written"
+  comments(first: Int @constraint(min: 0, max: 100), after: Cursor): CommentConnection!
+  "uploaded files, oldest first"
+  attachments: [Attachment!]!
+  "iCalendar RRULE repeating the todo from its first due date; null for one-off todos"
+  recurrence: String
+  "IANA time zone the recurrence keeps its wall clock time in"
+  recurrenceTimeZone: String
+  createdAt: DateTime!
+  updatedAt: DateTime!
+}
+
+enum TodoPriority {
+  NONE
+  LOW
+  MEDIUM
+  HIGH
+}
+
+type TodoEdge {
+  node: Todo!
+  cursor: Cursor!
+}
+
+type TodoConnection {
+  edges: [TodoEdge!]!
+  pageInfo: PageInfo!
+}
+
+input TodoFilter {
+  done: Boolean
+  textContains: String @constraint(maxLength: 256)
+  createdAfter: DateTime
+  createdBefore: DateTime
+  userId: Int
+  "todos carrying at least one of these tags"
+  tagsAny: [String!]
+  "todos carrying all of these tags"
+  tagsAll: [String!]
+  projectId: Int
+  "keeps todos of archived projects, which are otherwise left out unless projectId is set"
+  includeArchived: Boolean = false
+}
+
+enum TodoOrderField {
+  CREATED_AT
+  UPDATED_AT
+  TEXT
+  DONE
+  DUE_AT
+  PRIORITY
+}
+
+input TodoOrder {
+  field: TodoOrderField!
+  direction: OrderDirection! = ASC
+}
+
+type TodoSearchHit {
+  node: Todo!
+  cursor: Cursor!
+  rank: Float!
+  "HTML escaped title with matched words wrapped in <mark></mark>"
+  snippet: String!
+}
+
+type TodoSearchConnection {
+  edges: [TodoSearchHit!]!
+  pageInfo: PageInfo!
 }
 
 type Query {
-  todos: [Todo!]!
+  todos(userId: Int, filter: TodoFilter, orderBy: TodoOrder): [Todo!]!
+  todosConnection(first: Int @constraint(min: 0, max: 100), after: Cursor, last: Int @constraint(min: 0, max: 100), before: Cursor): TodoConnection!
   gettodo(id:String!):Todo!
+  trashedTodos: [Todo!]!
+  searchTodos(query: String! @constraint(minLength: 1, maxLength: 256), first: Int @constraint(min: 0, max: 100), after: Cursor): TodoSearchConnection!
+  "the first occurrences of an RRULE repeating from start, e.g. to preview it before saving"
+  previewRecurrence(recurrence: String! @constraint(maxLength: 512), start: DateTime!, timeZone: String, count: Int! = 5 @constraint(min: 1, max: 100)): [DateTime!]!
 }
 
 input NewTodo {
-  text: String!
-  userId: String!
+  text: String! @constraint(minLength: 1, maxLength: 1000)
+  userId: String! @constraint(pattern: "^[1-9][0-9]*$")
+  dueAt: DateTime
+  priority: TodoPriority = NONE
+  remindAt: DateTime
+  parentId: Int
+  autoComplete: Boolean = false
+  projectId: Int
+  "iCalendar RRULE, e.g. FREQ=WEEKLY;BYDAY=SA; needs dueAt"
+  recurrence: String @constraint(maxLength: 512)
+  "IANA time zone of the recurrence, UTC when omitted"
+  recurrenceTimeZone: String
+}
+
+input UpdateTodo {
+  text: String @constraint(minLength: 1, maxLength: 1000)
+  done: Boolean
+  dueAt: DateTime
+  priority: TodoPriority
+  remindAt: DateTime
+  "removes the due date; takes precedence over dueAt"
+  clearDueAt: Boolean
+  "removes the reminder; takes precedence over remindAt"
+  clearRemindAt: Boolean
+  autoComplete: Boolean
+  "restarts the series from the due date"
+  recurrence: String @constraint(maxLength: 512)
+  "IANA time zone of the recurrence; only with recurrence"
+  recurrenceTimeZone: String
+  "stops the todo from recurring; takes precedence over recurrence"
+  clearRecurrence: Boolean
+}
+
+input TodoPatch {
+  id: Int!
+  changes: UpdateTodo!
+  expectedVersion: Int
 }
 
 type Mutation {
   createTodo(input: NewTodo!): Todo!
+  """
+  Mutations taking expectedVersion fail with CONFLICT when the todo is no
+  longer at that version; extensions.current then holds its current state.
+  """
+  updateTodo(id: Int!, input: UpdateTodo!, expectedVersion: Int): Todo!
+  setTodoDone(id: Int!, done: Boolean!, expectedVersion: Int): Todo!
+  deleteTodo(id: Int!): Todo!
+  restoreTodo(id: Int!): Todo!
+  "moves a todo under parentId, or to the top level when parentId is null"
+  setTodoParent(id: Int!, parentId: Int, expectedVersion: Int): Todo!
+  purgeTodo(id: Int!): Boolean!
+  """
+  The batch mutations run in one transaction: either every item is written
+  or none is. On failure the result is null and each error carries the
+  index of the offending item in extensions.index.
+  """
+  createTodos(inputs: [NewTodo!]! @constraint(minLength: 1, maxLength: 500)): [Todo!]
+  updateTodos(inputs: [TodoPatch!]! @constraint(minLength: 1, maxLength: 500)): [Todo!]
+  deleteTodos(ids: [Int!]! @constraint(minLength: 1, maxLength: 500)): [Todo!]
+}
+
+enum TodoEventKind {
+  CREATED
+  UPDATED
+  DELETED
+}
+
+type TodoEvent {
+  kind: TodoEventKind!
+  todo: Todo!
+}
+
+type Subscription {
+  todoChanged(userId: Int): TodoEvent!
+}
+`, BuiltIn: false},
+	{Name: "../schema/user.gql", Input: `type User {
+  id: Int!
+  name: String!
+  todos: [Todo!]! @isOwner
+}
+
+input NewUser {
+  name: String! @constraint(minLength: 1)
+}
+
+extend type Query {
+  users: [User!]! @hasRole(role: ADMIN)
+  "a user other than the caller is visible to admins only"
+  user(id: Int!): User!
+  "the user the bearer token was issued to; fails with UNAUTHENTICATED without one"
+  me: User!
 }
 
+extend type Mutation {
+  createUser(input: NewUser!): User! @hasRole(role: ADMIN)
+}
 `, BuiltIn: false},
 	{Name: "../../federation/directives.graphql", Input: `
 	scalar _Any
@@ -251,11 +1810,25 @@ type Mutation {
 	directive @inaccessible on SCALAR | OBJECT | FIELD_DEFINITION | ARGUMENT_DEFINITION | INTERFACE | UNION | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
 `, BuiltIn: true},
 	{Name: "../../federation/entity.graphql", Input: `
+# a union of all types that use the @key directive
+union _Entity = Todo
+
+input TodoByIDsInput {
+	ID: Int!
+}
+
+# fake type to build resolver interfaces for users to implement
+type Entity {
+		findManyTodoByIDs(reps: [TodoByIDsInput!]!): [Todo]
+
+}
+
 type _Service {
   sdl: String
 }
 
 extend type Query {
+  _entities(representations: [_Any!]!): [_Entity]!
   _service: _Service!
 }
 `, BuiltIn: true},
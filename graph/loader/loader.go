@@ -0,0 +1,122 @@
+package loader
+
+import (
+	"context"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/dataloader"
+	"go-graph/service"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type ctxKey struct{}
+
+// Loaders holds the data loaders of one response, one per repository.
+type Loaders struct {
+	Todo *dataloader.Loader[int, *modelgen.Todo]
+	User *dataloader.Loader[int, *modelgen.User]
+	// Project loads the project of a todo, keyed by project id.
+	Project *dataloader.Loader[int, *modelgen.Project]
+	// ProjectTodos loads the todos of a project, keyed by project id.
+	ProjectTodos *dataloader.Loader[int, []*modelgen.Todo]
+	// TodoTags loads the tags of a todo, keyed by todo id.
+	TodoTags *dataloader.Loader[int, []*modelgen.Tag]
+	// TodoChildren and TodoProgress load the subtasks of a todo and their
+	// completion counts, keyed by parent id.
+	TodoChildren *dataloader.Loader[int, []*modelgen.Todo]
+	TodoProgress *dataloader.Loader[int, *service.TodoProgress]
+	// TodoHistory loads the audit events of a todo, keyed by todo id.
+	TodoHistory *dataloader.Loader[int, []*modelgen.AuditEvent]
+	// TodoComments loads the first page of comments of a todo; later pages
+	// are fetched one todo at a time.
+	TodoComments *dataloader.Loader[service.CommentPage, *modelgen.CommentConnection]
+	// TodoAttachments loads the files of a todo, keyed by todo id.
+	TodoAttachments *dataloader.Loader[int, []*modelgen.Attachment]
+}
+
+// New creates an empty set of loaders. Keys requested within wait, up to
+// maxBatch of them, are fetched together.
+func New(todoSvc *service.ServiceTodo, userSvc *service.ServiceUser, tagSvc *service.ServiceTag, auditSvc *service.ServiceAudit, projectSvc *service.ServiceProject, commentSvc *service.ServiceComment, attachmentSvc *service.ServiceAttachment, wait time.Duration, maxBatch int) *Loaders {
+	return &Loaders{
+		Todo:            dataloader.New(batch(todoSvc.GetTodosByIds, service.ErrTodoNotFound), wait, maxBatch),
+		User:            dataloader.New(batch(userSvc.GetUsersByIds, service.ErrUserNotFound), wait, maxBatch),
+		Project:         dataloader.New(batch(projectSvc.GetProjectsByIds, service.ErrProjectNotFound), wait, maxBatch),
+		ProjectTodos:    dataloader.New(list(todoSvc.GetTodosByProjectIds), wait, maxBatch),
+		TodoTags:        dataloader.New(list(tagSvc.GetTagsByTodoIds), wait, maxBatch),
+		TodoChildren:    dataloader.New(list(todoSvc.GetChildrenByTodoIds), wait, maxBatch),
+		TodoProgress:    dataloader.New(batch(todoSvc.GetProgressByTodoIds, service.ErrTodoNotFound), wait, maxBatch),
+		TodoHistory:     dataloader.New(list(auditSvc.GetTodoHistoryByIds), wait, maxBatch),
+		TodoComments:    dataloader.New(batch(commentSvc.GetFirstCommentPages, service.ErrTodoNotFound), wait, maxBatch),
+		TodoAttachments: dataloader.New(list(attachmentSvc.GetAttachmentsByTodoIds), wait, maxBatch),
+	}
+}
+
+// Extension is a handler extension that stores a fresh set of loaders from
+// New on the context of every response, so caching never outlives one
+// query or mutation. A subscription gets a new set for each event it
+// delivers rather than one for the lifetime of its websocket.
+type Extension struct {
+	New func() *Loaders
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "Loaders"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(WithLoaders(ctx, e.New()))
+}
+
+func WithLoaders(ctx context.Context, l *Loaders) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// For returns the loaders stored on ctx, or nil outside of Extension.
+func For(ctx context.Context) *Loaders {
+	l, _ := ctx.Value(ctxKey{}).(*Loaders)
+	return l
+}
+
+// batch adapts a service lookup that returns nil for missing rows into a
+// dataloader.BatchFunc reporting notFound for each missing key.
+func batch[K comparable, V any](find func(context.Context, []K) ([]*V, error), notFound error) dataloader.BatchFunc[K, *V] {
+	return func(ctx context.Context, keys []K) ([]*V, []error) {
+		values, err := find(ctx, keys)
+		if err != nil {
+			return nil, []error{err}
+		}
+		var errs []error
+		for i, v := range values {
+			if v != nil {
+				continue
+			}
+			if errs == nil {
+				errs = make([]error, len(keys))
+			}
+			errs[i] = notFound
+		}
+		return values, errs
+	}
+}
+
+// list adapts a service lookup returning one slice per key into a
+// dataloader.BatchFunc; a key without rows simply gets an empty slice.
+func list[V any](find func(context.Context, []int) ([][]*V, error)) dataloader.BatchFunc[int, []*V] {
+	return func(ctx context.Context, keys []int) ([][]*V, []error) {
+		values, err := find(ctx, keys)
+		if err != nil {
+			return nil, []error{err}
+		}
+		return values, nil
+	}
+}
@@ -0,0 +1,32 @@
+package loader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionCreatesLoadersPerResponse(t *testing.T) {
+	created := 0
+	ext := Extension{New: func() *Loaders {
+		created++
+		return &Loaders{}
+	}}
+	var seen []*Loaders
+	next := func(ctx context.Context) *graphql.Response {
+		seen = append(seen, For(ctx))
+		return &graphql.Response{}
+	}
+	// a subscription delivers each event through its own response
+	ctx := context.Background()
+	ext.InterceptResponse(ctx, next)
+	ext.InterceptResponse(ctx, next)
+	assert.Equal(t, 2, created)
+	require.Equal(t, 2, len(seen))
+	require.NotNil(t, seen[0])
+	assert.NotSame(t, seen[0], seen[1])
+	assert.Nil(t, For(ctx))
+}
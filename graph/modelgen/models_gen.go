@@ -2,13 +2,501 @@
 
 package modelgen
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Attachment struct {
+	ID       int    `json:"id"`
+	TodoID   int    `json:"todoId"`
+	Filename string `json:"filename"`
+	// sniffed from the contents
+	ContentType string `json:"contentType"`
+	// in bytes
+	Size int `json:"size"`
+	// hex encoded SHA-256 of the contents
+	Checksum  string    `json:"checksum"`
+	CreatedAt time.Time `json:"createdAt"`
+	// a signed link to download the file; it expires after a while
+	URL string `json:"url"`
+}
+
+// One row written by one statement.
+type AuditEvent struct {
+	ID int `json:"id"`
+	// table the row belongs to, e.g. todos
+	Entity   string  `json:"entity"`
+	EntityID int     `json:"entityId"`
+	Op       AuditOp `json:"op"`
+	// who made the change; null for anonymous requests and background jobs
+	Actor     *string `json:"actor"`
+	RequestID *string `json:"requestId"`
+	// the row before the write; null for creates
+	Before map[string]interface{} `json:"before"`
+	// the row after the write; null for permanent deletes
+	After     map[string]interface{} `json:"after"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+type AuditEventFilter struct {
+	Entity   *string    `json:"entity"`
+	EntityID *int       `json:"entityId"`
+	Actor    *string    `json:"actor"`
+	Op       *AuditOp   `json:"op"`
+	After    *time.Time `json:"after"`
+	Before   *time.Time `json:"before"`
+}
+
+type Comment struct {
+	ID       int   `json:"id"`
+	TodoID   int   `json:"todoId"`
+	AuthorID int   `json:"authorId"`
+	Author   *User `json:"author"`
+	// the comment this one replies to; null for top-level comments
+	ParentID *int `json:"parentId"`
+	// empty once the comment is deleted
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	// when the author last changed the body
+	EditedAt *time.Time `json:"editedAt"`
+	// deleted comments are kept without their body so that replies stay threaded
+	DeletedAt *time.Time `json:"deletedAt"`
+}
+
+type CommentConnection struct {
+	Edges    []*CommentEdge `json:"edges"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+}
+
+type CommentEdge struct {
+	Node   *Comment `json:"node"`
+	Cursor string   `json:"cursor"`
+}
+
+type NewProject struct {
+	Name  string  `json:"name"`
+	Color *string `json:"color"`
+}
+
 type NewTodo struct {
-	Text   string `json:"text"`
-	UserID string `json:"userId"`
+	Text         string        `json:"text"`
+	UserID       string        `json:"userId"`
+	DueAt        *time.Time    `json:"dueAt"`
+	Priority     *TodoPriority `json:"priority"`
+	RemindAt     *time.Time    `json:"remindAt"`
+	ParentID     *int          `json:"parentId"`
+	AutoComplete *bool         `json:"autoComplete"`
+	ProjectID    *int          `json:"projectId"`
+	// iCalendar RRULE, e.g. FREQ=WEEKLY;BYDAY=SA; needs dueAt
+	Recurrence *string `json:"recurrence"`
+	// IANA time zone of the recurrence, UTC when omitted
+	RecurrenceTimeZone *string `json:"recurrenceTimeZone"`
 }
 
-type Todo struct {
+type NewUser struct {
+	Name string `json:"name"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type Project struct {
 	ID   int    `json:"id"`
-	Text string `json:"text"`
-	Done bool   `json:"done"`
+	Name string `json:"name"`
+	// #rrggbb hex color; null when none was picked
+	Color *string `json:"color"`
+	// archived projects keep their todos but hide them from the default listings
+	Archived bool `json:"archived"`
+	// the user who created the project; only they and admins may change it
+	OwnerID   *int      `json:"ownerId"`
+	Todos     []*Todo   `json:"todos"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Tag struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Todo struct {
+	ID       int          `json:"id"`
+	Text     string       `json:"text"`
+	Done     bool         `json:"done"`
+	UserID   *int         `json:"userId"`
+	User     *User        `json:"user"`
+	Tags     []*Tag       `json:"tags"`
+	DueAt    *time.Time   `json:"dueAt"`
+	Priority TodoPriority `json:"priority"`
+	// when the owner should be reminded; null once cleared
+	RemindAt *time.Time `json:"remindAt"`
+	ParentID *int       `json:"parentId"`
+	Parent   *Todo      `json:"parent"`
+	// direct subtasks in creation order
+	Children          []*Todo `json:"children"`
+	CompletedChildren int     `json:"completedChildren"`
+	TotalChildren     int     `json:"totalChildren"`
+	// whether the todo is marked done once all of its children are done
+	AutoComplete bool `json:"autoComplete"`
+	// incremented on every write; pass it as expectedVersion to detect concurrent edits
+	Version int `json:"version"`
+	// every recorded write to the todo, oldest first
+	History   []*AuditEvent `json:"history"`
+	ProjectID *int          `json:"projectId"`
+	Project   *Project      `json:"project"`
+	// comments in the order they were written
+	Comments *CommentConnection `json:"comments"`
+	// uploaded files, oldest first
+	Attachments []*Attachment `json:"attachments"`
+	// iCalendar RRULE repeating the todo from its first due date; null for one-off todos
+	Recurrence *string `json:"recurrence"`
+	// IANA time zone the recurrence keeps its wall clock time in
+	RecurrenceTimeZone *string   `json:"recurrenceTimeZone"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+func (Todo) IsEntity() {}
+
+type TodoConnection struct {
+	Edges    []*TodoEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type TodoEdge struct {
+	Node   *Todo  `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+type TodoFilter struct {
+	Done          *bool      `json:"done"`
+	TextContains  *string    `json:"textContains"`
+	CreatedAfter  *time.Time `json:"createdAfter"`
+	CreatedBefore *time.Time `json:"createdBefore"`
+	UserID        *int       `json:"userId"`
+	// todos carrying at least one of these tags
+	TagsAny []string `json:"tagsAny"`
+	// todos carrying all of these tags
+	TagsAll   []string `json:"tagsAll"`
+	ProjectID *int     `json:"projectId"`
+	// keeps todos of archived projects, which are otherwise left out unless projectId is set
+	IncludeArchived *bool `json:"includeArchived"`
+}
+
+type TodoOrder struct {
+	Field     TodoOrderField `json:"field"`
+	Direction OrderDirection `json:"direction"`
+}
+
+type TodoPatch struct {
+	ID              int         `json:"id"`
+	Changes         *UpdateTodo `json:"changes"`
+	ExpectedVersion *int        `json:"expectedVersion"`
+}
+
+type TodoSearchConnection struct {
+	Edges    []*TodoSearchHit `json:"edges"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type TodoSearchHit struct {
+	Node   *Todo   `json:"node"`
+	Cursor string  `json:"cursor"`
+	Rank   float64 `json:"rank"`
+	// HTML escaped title with matched words wrapped in <mark></mark>
+	Snippet string `json:"snippet"`
+}
+
+type UpdateTodo struct {
+	Text     *string       `json:"text"`
+	Done     *bool         `json:"done"`
+	DueAt    *time.Time    `json:"dueAt"`
+	Priority *TodoPriority `json:"priority"`
+	RemindAt *time.Time    `json:"remindAt"`
+	// removes the due date; takes precedence over dueAt
+	ClearDueAt *bool `json:"clearDueAt"`
+	// removes the reminder; takes precedence over remindAt
+	ClearRemindAt *bool `json:"clearRemindAt"`
+	AutoComplete  *bool `json:"autoComplete"`
+	// restarts the series from the due date
+	Recurrence *string `json:"recurrence"`
+	// IANA time zone of the recurrence; only with recurrence
+	RecurrenceTimeZone *string `json:"recurrenceTimeZone"`
+	// stops the todo from recurring; takes precedence over recurrence
+	ClearRecurrence *bool `json:"clearRecurrence"`
+}
+
+type User struct {
+	ID    int     `json:"id"`
+	Name  string  `json:"name"`
+	Todos []*Todo `json:"todos"`
+}
+
+type AuditOp string
+
+const (
+	AuditOpCreate AuditOp = "CREATE"
+	AuditOpUpdate AuditOp = "UPDATE"
+	AuditOpDelete AuditOp = "DELETE"
+)
+
+var AllAuditOp = []AuditOp{
+	AuditOpCreate,
+	AuditOpUpdate,
+	AuditOpDelete,
+}
+
+func (e AuditOp) IsValid() bool {
+	switch e {
+	case AuditOpCreate, AuditOpUpdate, AuditOpDelete:
+		return true
+	}
+	return false
+}
+
+func (e AuditOp) String() string {
+	return string(e)
+}
+
+func (e *AuditOp) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditOp", str)
+	}
+	return nil
+}
+
+func (e AuditOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Role string
+
+const (
+	RoleAdmin Role = "ADMIN"
+	RoleUser  Role = "USER"
+)
+
+var AllRole = []Role{
+	RoleAdmin,
+	RoleUser,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleAdmin, RoleUser:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoEventKind string
+
+const (
+	TodoEventKindCreated TodoEventKind = "CREATED"
+	TodoEventKindUpdated TodoEventKind = "UPDATED"
+	TodoEventKindDeleted TodoEventKind = "DELETED"
+)
+
+var AllTodoEventKind = []TodoEventKind{
+	TodoEventKindCreated,
+	TodoEventKindUpdated,
+	TodoEventKindDeleted,
+}
+
+func (e TodoEventKind) IsValid() bool {
+	switch e {
+	case TodoEventKindCreated, TodoEventKindUpdated, TodoEventKindDeleted:
+		return true
+	}
+	return false
+}
+
+func (e TodoEventKind) String() string {
+	return string(e)
+}
+
+func (e *TodoEventKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoEventKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoEventKind", str)
+	}
+	return nil
+}
+
+func (e TodoEventKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoOrderField string
+
+const (
+	TodoOrderFieldCreatedAt TodoOrderField = "CREATED_AT"
+	TodoOrderFieldUpdatedAt TodoOrderField = "UPDATED_AT"
+	TodoOrderFieldText      TodoOrderField = "TEXT"
+	TodoOrderFieldDone      TodoOrderField = "DONE"
+	TodoOrderFieldDueAt     TodoOrderField = "DUE_AT"
+	TodoOrderFieldPriority  TodoOrderField = "PRIORITY"
+)
+
+var AllTodoOrderField = []TodoOrderField{
+	TodoOrderFieldCreatedAt,
+	TodoOrderFieldUpdatedAt,
+	TodoOrderFieldText,
+	TodoOrderFieldDone,
+	TodoOrderFieldDueAt,
+	TodoOrderFieldPriority,
+}
+
+func (e TodoOrderField) IsValid() bool {
+	switch e {
+	case TodoOrderFieldCreatedAt, TodoOrderFieldUpdatedAt, TodoOrderFieldText, TodoOrderFieldDone, TodoOrderFieldDueAt, TodoOrderFieldPriority:
+		return true
+	}
+	return false
+}
+
+func (e TodoOrderField) String() string {
+	return string(e)
+}
+
+func (e *TodoOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoOrderField", str)
+	}
+	return nil
+}
+
+func (e TodoOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoPriority string
+
+const (
+	TodoPriorityNone   TodoPriority = "NONE"
+	TodoPriorityLow    TodoPriority = "LOW"
+	TodoPriorityMedium TodoPriority = "MEDIUM"
+	TodoPriorityHigh   TodoPriority = "HIGH"
+)
+
+var AllTodoPriority = []TodoPriority{
+	TodoPriorityNone,
+	TodoPriorityLow,
+	TodoPriorityMedium,
+	TodoPriorityHigh,
+}
+
+func (e TodoPriority) IsValid() bool {
+	switch e {
+	case TodoPriorityNone, TodoPriorityLow, TodoPriorityMedium, TodoPriorityHigh:
+		return true
+	}
+	return false
+}
+
+func (e TodoPriority) String() string {
+	return string(e)
+}
+
+func (e *TodoPriority) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoPriority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoPriority", str)
+	}
+	return nil
+}
+
+func (e TodoPriority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
@@ -0,0 +1,10 @@
+package modelgen
+
+// TodoEvent is bound in gqlgen.yml rather than generated so that it can
+// carry the tenant of its todo, which subscribers are filtered by but which
+// is not part of the schema.
+type TodoEvent struct {
+	Kind   TodoEventKind `json:"kind"`
+	Todo   *Todo         `json:"todo"`
+	Tenant string        `json:"-"`
+}
@@ -0,0 +1,17 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/modelgen"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// AddAttachment is the resolver for the addAttachment field.
+func (r *mutationResolver) AddAttachment(ctx context.Context, todoID int, file graphql.Upload) (*modelgen.Attachment, error) {
+	return r.attachmentSvc.AddAttachment(ctx, todoID, file.Filename, file.File)
+}
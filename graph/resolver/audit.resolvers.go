@@ -0,0 +1,15 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/modelgen"
+)
+
+// AuditEvents is the resolver for the auditEvents field.
+func (r *queryResolver) AuditEvents(ctx context.Context, filter *modelgen.AuditEventFilter, first *int) ([]*modelgen.AuditEvent, error) {
+	return r.auditSvc.GetAuditEvents(ctx, filter, first)
+}
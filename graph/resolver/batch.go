@@ -0,0 +1,27 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"go-graph/graph/modelgen"
+	"go-graph/service"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// batchResult reports every item of a service.BatchError as an error of its
+// own, with the index of the item in extensions.index, and resolves the
+// field to null. Other errors are returned unchanged.
+func batchResult(ctx context.Context, todos []*modelgen.Todo, err error) ([]*modelgen.Todo, error) {
+	var batchErr service.BatchError
+	if !errors.As(err, &batchErr) {
+		return todos, err
+	}
+	for _, item := range batchErr {
+		gqlErr := gqlerror.WrapPath(graphql.GetPath(ctx), item.Err)
+		gqlErr.Extensions = map[string]interface{}{"index": item.Index}
+		graphql.AddError(ctx, gqlErr)
+	}
+	return nil, nil
+}
@@ -0,0 +1,42 @@
+package resolver
+
+import (
+	"encoding/json"
+	"go-graph/db/model"
+	"go-graph/graph/generated"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestDeleteTodosReportsItemIndex(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1"},
+		},
+	}}
+	r := newTestResolver(todoRepo, &testutil.MockRepo[model.User]{})
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(r.Config())))
+
+	var resp struct {
+		DeleteTodos []struct{ ID int }
+	}
+	err := c.Post(`mutation { deleteTodos(ids: [1, 7, 1]) { id } }`, &resp)
+	require.Error(t, err)
+	assert.Nil(t, resp.DeleteTodos)
+
+	var errs []struct {
+		Message    string
+		Extensions struct{ Index int }
+	}
+	require.NoError(t, json.Unmarshal([]byte(err.Error()), &errs))
+	require.Equal(t, 2, len(errs))
+	assert.Equal(t, 1, errs[0].Extensions.Index)
+	assert.Equal(t, "todo not found", errs[0].Message)
+	assert.Equal(t, 2, errs[1].Extensions.Index)
+}
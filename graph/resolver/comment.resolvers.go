@@ -0,0 +1,36 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/generated"
+	"go-graph/graph/modelgen"
+)
+
+// Author is the resolver for the author field.
+func (r *commentResolver) Author(ctx context.Context, obj *modelgen.Comment) (*modelgen.User, error) {
+	return r.loaders(ctx).User.Load(ctx, obj.AuthorID)
+}
+
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, todoID int, body string, parentID *int) (*modelgen.Comment, error) {
+	return r.commentSvc.AddComment(ctx, todoID, body, parentID)
+}
+
+// EditComment is the resolver for the editComment field.
+func (r *mutationResolver) EditComment(ctx context.Context, id int, body string) (*modelgen.Comment, error) {
+	return r.commentSvc.EditComment(ctx, id, body)
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id int) (*modelgen.Comment, error) {
+	return r.commentSvc.DeleteComment(ctx, id)
+}
+
+// Comment returns generated.CommentResolver implementation.
+func (r *Resolver) Comment() generated.CommentResolver { return &commentResolver{r} }
+
+type commentResolver struct{ *Resolver }
@@ -0,0 +1,25 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/generated"
+	"go-graph/graph/modelgen"
+)
+
+// FindManyTodoByIDs is the resolver for the findManyTodoByIDs field.
+func (r *entityResolver) FindManyTodoByIDs(ctx context.Context, reps []*generated.TodoByIDsInput) ([]*modelgen.Todo, error) {
+	ids := make([]int, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	return r.todoSvc.GetTodosByIds(ctx, ids)
+}
+
+// Entity returns generated.EntityResolver implementation.
+func (r *Resolver) Entity() generated.EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
@@ -0,0 +1,52 @@
+package resolver
+
+import (
+	"go-graph/db/model"
+	"go-graph/graph/generated"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestEntitiesTodo(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1"},
+			{Model: gorm.Model{ID: 2}, Title: "task 2", Done: true},
+		},
+	}}
+	userRepo := &testutil.MockRepo[model.User]{}
+	r := newTestResolver(todoRepo, userRepo)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(r.Config())))
+
+	var resp struct {
+		Entities []*struct {
+			ID   int
+			Text string
+			Done bool
+		} `json:"_entities"`
+	}
+	err := c.Post(`query($representations: [_Any!]!) {
+		_entities(representations: $representations) {
+			... on Todo { id text done }
+		}
+	}`, &resp, client.Var("representations", []map[string]any{
+		{"__typename": "Todo", "id": 2},
+		{"__typename": "Todo", "id": 3},
+		{"__typename": "Todo", "id": 1},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Entities))
+	require.NotNil(t, resp.Entities[0])
+	assert.Equal(t, 2, resp.Entities[0].ID)
+	assert.Equal(t, "task 2", resp.Entities[0].Text)
+	assert.True(t, resp.Entities[0].Done)
+	assert.Nil(t, resp.Entities[1])
+	require.NotNil(t, resp.Entities[2])
+	assert.Equal(t, 1, resp.Entities[2].ID)
+}
@@ -0,0 +1,51 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/generated"
+	"go-graph/graph/modelgen"
+)
+
+// CreateProject is the resolver for the createProject field.
+func (r *mutationResolver) CreateProject(ctx context.Context, input modelgen.NewProject) (*modelgen.Project, error) {
+	return r.projectSvc.NewProject(ctx, &input)
+}
+
+// RenameProject is the resolver for the renameProject field.
+func (r *mutationResolver) RenameProject(ctx context.Context, id int, name string) (*modelgen.Project, error) {
+	return r.projectSvc.RenameProject(ctx, id, name)
+}
+
+// ArchiveProject is the resolver for the archiveProject field.
+func (r *mutationResolver) ArchiveProject(ctx context.Context, id int, archived bool) (*modelgen.Project, error) {
+	return r.projectSvc.ArchiveProject(ctx, id, archived)
+}
+
+// MoveTodo is the resolver for the moveTodo field.
+func (r *mutationResolver) MoveTodo(ctx context.Context, id int, projectID *int, expectedVersion *int) (*modelgen.Todo, error) {
+	return r.todoSvc.MoveTodo(ctx, id, projectID, expectedVersion)
+}
+
+// Todos is the resolver for the todos field.
+func (r *projectResolver) Todos(ctx context.Context, obj *modelgen.Project) ([]*modelgen.Todo, error) {
+	return r.loaders(ctx).ProjectTodos.Load(ctx, obj.ID)
+}
+
+// Projects is the resolver for the projects field.
+func (r *queryResolver) Projects(ctx context.Context, includeArchived *bool) ([]*modelgen.Project, error) {
+	return r.projectSvc.GetProjects(ctx, includeArchived)
+}
+
+// Project is the resolver for the project field.
+func (r *queryResolver) Project(ctx context.Context, id int) (*modelgen.Project, error) {
+	return r.projectSvc.GetProject(ctx, id)
+}
+
+// Project returns generated.ProjectResolver implementation.
+func (r *Resolver) Project() generated.ProjectResolver { return &projectResolver{r} }
+
+type projectResolver struct{ *Resolver }
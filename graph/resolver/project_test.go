@@ -0,0 +1,52 @@
+package resolver
+
+import (
+	"go-graph/db/model"
+	"go-graph/graph/generated"
+	"go-graph/graph/loader"
+	"go-graph/service"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestProjectTodosAreBatched(t *testing.T) {
+	home, work := uint(1), uint(2)
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "dishes", ProjectID: &home},
+			{Model: gorm.Model{ID: 2}, Title: "report", ProjectID: &work},
+			{Model: gorm.Model{ID: 3}, Title: "laundry", ProjectID: &home},
+		},
+	}}
+	projectRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Models: []*model.Project{
+			{Model: gorm.Model{ID: home}, Name: "Home"},
+			{Model: gorm.Model{ID: work}, Name: "Work"},
+		},
+	}}
+	r := newTestResolver(todoRepo, &testutil.MockRepo[model.User]{})
+	r.projectSvc = service.NewServiceProject(projectRepo)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(r.Config()))
+	srv.Use(loader.Extension{New: r.NewLoaders})
+	c := client.New(srv)
+
+	var resp struct {
+		Projects []struct {
+			Name  string
+			Todos []struct{ Text string }
+		}
+	}
+	c.MustPost(`{ projects { name todos { text } } }`, &resp)
+	require.Equal(t, 2, len(resp.Projects))
+	require.Equal(t, 2, len(resp.Projects[0].Todos))
+	assert.Equal(t, "laundry", resp.Projects[0].Todos[1].Text)
+	require.Equal(t, 1, len(resp.Projects[1].Todos))
+	require.Equal(t, 1, len(todoRepo.ProjectLookups))
+	assert.ElementsMatch(t, []uint{home, work}, todoRepo.ProjectLookups[0])
+}
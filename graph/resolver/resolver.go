@@ -1,8 +1,22 @@
 package resolver
 
 import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"go-graph/db"
 	"go-graph/db/model"
+	"go-graph/graph/directive"
+	"go-graph/graph/generated"
+	"go-graph/graph/loader"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/config"
+	"go-graph/pkg/pubsub"
+	"go-graph/pkg/storage"
 	"go-graph/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
 // This file will not be regenerated automatically.
@@ -11,12 +25,96 @@ import (
 
 type Resolver struct {
 	// add on demand services here
-	todoSvc *service.ServiceTodo
+	todoSvc       *service.ServiceTodo
+	userSvc       *service.ServiceUser
+	tagSvc        *service.ServiceTag
+	auditSvc      *service.ServiceAudit
+	projectSvc    *service.ServiceProject
+	commentSvc    *service.ServiceComment
+	attachmentSvc *service.ServiceAttachment
+
+	loaderWait     time.Duration
+	loaderMaxBatch int
 }
 
 func New() *Resolver {
+	conn := db.GetConnection()
+	if err := conn.Use(model.Auditor{}); err != nil {
+		panic(fmt.Errorf("error registering audit plugin: %v", err))
+	}
+	todoRepo := model.NewTodoRepo(conn)
+	userRepo := model.NewUserRepo(conn)
+	tagRepo := model.NewTagRepo(conn)
+	projectRepo := model.NewProjectRepo(conn)
+	conf := config.GetServerConfig()
+	store, err := storage.NewLocal(conf.GetStorageDir())
+	if err != nil {
+		panic(fmt.Errorf("error opening attachment storage: %v", err))
+	}
+	todoEvents := pubsub.NewBroker[*modelgen.TodoEvent](conf.GetSubscriptionBuffer())
+	todoSvc := service.NewServiceTodo(todoRepo, userRepo, tagRepo, projectRepo, todoEvents, store)
 	return &Resolver{
 		// create a new service here
-		todoSvc: service.NewServiceTodo(model.NewDefaultTodoRepo()),
+		todoSvc:       todoSvc,
+		userSvc:       service.NewServiceUser(userRepo),
+		tagSvc:        service.NewServiceTag(tagRepo),
+		auditSvc:      service.NewServiceAudit(model.NewAuditRepo(conn)),
+		projectSvc:    service.NewServiceProject(projectRepo),
+		commentSvc:    service.NewServiceComment(model.NewCommentRepo(conn), todoSvc),
+		attachmentSvc: service.NewServiceAttachment(model.NewAttachmentRepo(conn), store, downloadSigner(conf), todoSvc, conf.GetUploadMaxSize()),
+
+		loaderWait:     conf.GetLoaderWait(),
+		loaderMaxBatch: conf.GetLoaderMaxBatch(),
+	}
+}
+
+// Config wires r and the schema directives into the executable schema.
+func (r *Resolver) Config() generated.Config {
+	return generated.Config{
+		Resolvers: r,
+		Directives: generated.DirectiveRoot{
+			Constraint: directive.Constraint,
+			HasRole:    directive.HasRole,
+			IsOwner:    directive.IsOwner,
+		},
+	}
+}
+
+// TodoService exposes the todo service to background jobs started by cmd.
+func (r *Resolver) TodoService() *service.ServiceTodo {
+	return r.todoSvc
+}
+
+// AttachmentService exposes the attachment service to the download handler.
+func (r *Resolver) AttachmentService() *service.ServiceAttachment {
+	return r.attachmentSvc
+}
+
+// downloadSigner signs download links with the configured secret. Without
+// one a random secret is picked, and links stop working on restart.
+func downloadSigner(conf config.ServerConfig) *storage.Signer {
+	secret := []byte(conf.GetDownloadSecret())
+	if len(secret) == 0 {
+		log.Warn().Msg("no download-secret configured, download links expire on restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(fmt.Errorf("error generating download secret: %v", err))
+		}
+	}
+	return storage.NewSigner(secret, conf.GetDownloadTTL())
+}
+
+// NewLoaders creates the data loaders installed by loader.Extension for
+// every response.
+func (r *Resolver) NewLoaders() *loader.Loaders {
+	return loader.New(r.todoSvc, r.userSvc, r.tagSvc, r.auditSvc, r.projectSvc, r.commentSvc, r.attachmentSvc, r.loaderWait, r.loaderMaxBatch)
+}
+
+// loaders returns the loaders of the current response. Outside of
+// loader.Extension, e.g. in tests, a throwaway set is created.
+func (r *Resolver) loaders(ctx context.Context) *loader.Loaders {
+	if l := loader.For(ctx); l != nil {
+		return l
 	}
+	return r.NewLoaders()
 }
@@ -0,0 +1,30 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/modelgen"
+)
+
+// AddTag is the resolver for the addTag field.
+func (r *mutationResolver) AddTag(ctx context.Context, todoID int, name string) (*modelgen.Todo, error) {
+	return r.todoSvc.AddTag(ctx, todoID, name)
+}
+
+// RemoveTag is the resolver for the removeTag field.
+func (r *mutationResolver) RemoveTag(ctx context.Context, todoID int, name string) (*modelgen.Todo, error) {
+	return r.todoSvc.RemoveTag(ctx, todoID, name)
+}
+
+// RenameTag is the resolver for the renameTag field.
+func (r *mutationResolver) RenameTag(ctx context.Context, id int, name string) (*modelgen.Tag, error) {
+	return r.tagSvc.RenameTag(ctx, id, name)
+}
+
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context) ([]*modelgen.Tag, error) {
+	return r.tagSvc.GetTags(ctx)
+}
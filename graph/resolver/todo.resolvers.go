@@ -9,6 +9,8 @@ import (
 	"context"
 	"go-graph/graph/generated"
 	"go-graph/graph/modelgen"
+	"go-graph/service"
+	"time"
 )
 
 // CreateTodo is the resolver for the createTodo field.
@@ -16,9 +18,62 @@ func (r *mutationResolver) CreateTodo(ctx context.Context, input modelgen.NewTod
 	return r.todoSvc.NewTodo(ctx, &input)
 }
 
+// UpdateTodo is the resolver for the updateTodo field.
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id int, input modelgen.UpdateTodo, expectedVersion *int) (*modelgen.Todo, error) {
+	return r.todoSvc.UpdateTodo(ctx, id, &input, expectedVersion)
+}
+
+// SetTodoDone is the resolver for the setTodoDone field.
+func (r *mutationResolver) SetTodoDone(ctx context.Context, id int, done bool, expectedVersion *int) (*modelgen.Todo, error) {
+	return r.todoSvc.SetTodoDone(ctx, id, done, expectedVersion)
+}
+
+// DeleteTodo is the resolver for the deleteTodo field.
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id int) (*modelgen.Todo, error) {
+	return r.todoSvc.DeleteTodo(ctx, id)
+}
+
+// RestoreTodo is the resolver for the restoreTodo field.
+func (r *mutationResolver) RestoreTodo(ctx context.Context, id int) (*modelgen.Todo, error) {
+	return r.todoSvc.RestoreTodo(ctx, id)
+}
+
+// SetTodoParent is the resolver for the setTodoParent field.
+func (r *mutationResolver) SetTodoParent(ctx context.Context, id int, parentID *int, expectedVersion *int) (*modelgen.Todo, error) {
+	return r.todoSvc.SetTodoParent(ctx, id, parentID, expectedVersion)
+}
+
+// PurgeTodo is the resolver for the purgeTodo field.
+func (r *mutationResolver) PurgeTodo(ctx context.Context, id int) (bool, error) {
+	return r.todoSvc.PurgeTodo(ctx, id)
+}
+
+// CreateTodos is the resolver for the createTodos field.
+func (r *mutationResolver) CreateTodos(ctx context.Context, inputs []*modelgen.NewTodo) ([]*modelgen.Todo, error) {
+	todos, err := r.todoSvc.CreateTodos(ctx, inputs)
+	return batchResult(ctx, todos, err)
+}
+
+// UpdateTodos is the resolver for the updateTodos field.
+func (r *mutationResolver) UpdateTodos(ctx context.Context, inputs []*modelgen.TodoPatch) ([]*modelgen.Todo, error) {
+	todos, err := r.todoSvc.UpdateTodos(ctx, inputs)
+	return batchResult(ctx, todos, err)
+}
+
+// DeleteTodos is the resolver for the deleteTodos field.
+func (r *mutationResolver) DeleteTodos(ctx context.Context, ids []int) ([]*modelgen.Todo, error) {
+	todos, err := r.todoSvc.DeleteTodos(ctx, ids)
+	return batchResult(ctx, todos, err)
+}
+
 // Todos is the resolver for the todos field.
-func (r *queryResolver) Todos(ctx context.Context) ([]*modelgen.Todo, error) {
-	return r.todoSvc.GetTodos(ctx)
+func (r *queryResolver) Todos(ctx context.Context, userID *int, filter *modelgen.TodoFilter, orderBy *modelgen.TodoOrder) ([]*modelgen.Todo, error) {
+	return r.todoSvc.GetTodos(ctx, userID, filter, orderBy)
+}
+
+// TodosConnection is the resolver for the todosConnection field.
+func (r *queryResolver) TodosConnection(ctx context.Context, first *int, after *string, last *int, before *string) (*modelgen.TodoConnection, error) {
+	return r.todoSvc.GetTodosConnection(ctx, first, after, last, before)
 }
 
 // Gettodo is the resolver for the gettodo field.
@@ -26,11 +81,113 @@ func (r *queryResolver) Gettodo(ctx context.Context, id string) (*modelgen.Todo,
 	return r.todoSvc.GetTodo(ctx, id)
 }
 
+// TrashedTodos is the resolver for the trashedTodos field.
+func (r *queryResolver) TrashedTodos(ctx context.Context) ([]*modelgen.Todo, error) {
+	return r.todoSvc.GetTrashedTodos(ctx)
+}
+
+// SearchTodos is the resolver for the searchTodos field.
+func (r *queryResolver) SearchTodos(ctx context.Context, query string, first *int, after *string) (*modelgen.TodoSearchConnection, error) {
+	return r.todoSvc.SearchTodos(ctx, query, first, after)
+}
+
+// PreviewRecurrence is the resolver for the previewRecurrence field.
+func (r *queryResolver) PreviewRecurrence(ctx context.Context, recurrence string, start time.Time, timeZone *string, count int) ([]*time.Time, error) {
+	return r.todoSvc.PreviewRecurrence(ctx, recurrence, start, timeZone, count)
+}
+
+// TodoChanged is the resolver for the todoChanged field.
+func (r *subscriptionResolver) TodoChanged(ctx context.Context, userID *int) (<-chan *modelgen.TodoEvent, error) {
+	return r.todoSvc.SubscribeTodoChanges(ctx, userID)
+}
+
+// User is the resolver for the user field.
+func (r *todoResolver) User(ctx context.Context, obj *modelgen.Todo) (*modelgen.User, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+	return r.loaders(ctx).User.Load(ctx, *obj.UserID)
+}
+
+// Tags is the resolver for the tags field.
+func (r *todoResolver) Tags(ctx context.Context, obj *modelgen.Todo) ([]*modelgen.Tag, error) {
+	return r.loaders(ctx).TodoTags.Load(ctx, obj.ID)
+}
+
+// Parent is the resolver for the parent field.
+func (r *todoResolver) Parent(ctx context.Context, obj *modelgen.Todo) (*modelgen.Todo, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+	return r.loaders(ctx).Todo.Load(ctx, *obj.ParentID)
+}
+
+// Children is the resolver for the children field.
+func (r *todoResolver) Children(ctx context.Context, obj *modelgen.Todo) ([]*modelgen.Todo, error) {
+	return r.loaders(ctx).TodoChildren.Load(ctx, obj.ID)
+}
+
+// CompletedChildren is the resolver for the completedChildren field.
+func (r *todoResolver) CompletedChildren(ctx context.Context, obj *modelgen.Todo) (int, error) {
+	p, err := r.loaders(ctx).TodoProgress.Load(ctx, obj.ID)
+	if err != nil {
+		return 0, err
+	}
+	return p.Completed, nil
+}
+
+// TotalChildren is the resolver for the totalChildren field.
+func (r *todoResolver) TotalChildren(ctx context.Context, obj *modelgen.Todo) (int, error) {
+	p, err := r.loaders(ctx).TodoProgress.Load(ctx, obj.ID)
+	if err != nil {
+		return 0, err
+	}
+	return p.Total, nil
+}
+
+// History is the resolver for the history field.
+func (r *todoResolver) History(ctx context.Context, obj *modelgen.Todo) ([]*modelgen.AuditEvent, error) {
+	return r.loaders(ctx).TodoHistory.Load(ctx, obj.ID)
+}
+
+// Project is the resolver for the project field.
+func (r *todoResolver) Project(ctx context.Context, obj *modelgen.Todo) (*modelgen.Project, error) {
+	if obj.ProjectID == nil {
+		return nil, nil
+	}
+	return r.loaders(ctx).Project.Load(ctx, *obj.ProjectID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *todoResolver) Comments(ctx context.Context, obj *modelgen.Todo, first *int, after *string) (*modelgen.CommentConnection, error) {
+	if after != nil {
+		return r.commentSvc.GetComments(ctx, obj.ID, first, after)
+	}
+	page, err := service.FirstCommentPage(obj.ID, first)
+	if err != nil {
+		return nil, err
+	}
+	return r.loaders(ctx).TodoComments.Load(ctx, page)
+}
+
+// Attachments is the resolver for the attachments field.
+func (r *todoResolver) Attachments(ctx context.Context, obj *modelgen.Todo) ([]*modelgen.Attachment, error) {
+	return r.loaders(ctx).TodoAttachments.Load(ctx, obj.ID)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+// Todo returns generated.TodoResolver implementation.
+func (r *Resolver) Todo() generated.TodoResolver { return &todoResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type todoResolver struct{ *Resolver }
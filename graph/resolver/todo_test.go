@@ -0,0 +1,233 @@
+package resolver
+
+import (
+	"encoding/json"
+	"go-graph/db/model"
+	"go-graph/graph/directive"
+	"go-graph/graph/generated"
+	"go-graph/graph/loader"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/pubsub"
+	"go-graph/pkg/storage"
+	"go-graph/service"
+	testutil "go-graph/test"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newTestResolver wires a Resolver over mock repositories; tags, projects,
+// comments and the audit log start empty.
+func newTestResolver(todoRepo model.TodoRepo, userRepo model.UserRepo) *Resolver {
+	tagRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{}}
+	projectRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{}}
+	todoSvc := service.NewServiceTodo(todoRepo, userRepo, tagRepo, projectRepo, pubsub.NewBroker[*modelgen.TodoEvent](1), &testutil.MockStorage{})
+	return &Resolver{
+		todoSvc:        todoSvc,
+		userSvc:        service.NewServiceUser(userRepo),
+		tagSvc:         service.NewServiceTag(tagRepo),
+		auditSvc:       service.NewServiceAudit(&testutil.MockAuditRepo{}),
+		projectSvc:     service.NewServiceProject(projectRepo),
+		commentSvc:     service.NewServiceComment(&testutil.MockCommentRepo{MockRepo: &testutil.MockRepo[model.Comment]{}}, todoSvc),
+		attachmentSvc:  service.NewServiceAttachment(&testutil.MockAttachmentRepo{MockRepo: &testutil.MockRepo[model.Attachment]{}}, &testutil.MockStorage{}, storage.NewSigner([]byte("secret"), time.Minute), todoSvc, 0),
+		loaderWait:     time.Millisecond,
+		loaderMaxBatch: 100,
+	}
+}
+
+func TestTodosUserIsBatched(t *testing.T) {
+	var (
+		alice = uint(1)
+		bob   = uint(2)
+	)
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1", UserID: &alice},
+			{Model: gorm.Model{ID: 2}, Title: "task 2", UserID: &bob},
+			{Model: gorm.Model{ID: 3}, Title: "task 3", UserID: &alice},
+		},
+	}}
+	userRepo := &testutil.MockRepo[model.User]{
+		Models: []*model.User{
+			{Model: gorm.Model{ID: 1}, Name: "alice"},
+			{Model: gorm.Model{ID: 2}, Name: "bob"},
+		},
+	}
+	r := newTestResolver(todoRepo, userRepo)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(r.Config()))
+	srv.Use(loader.Extension{New: r.NewLoaders})
+	c := client.New(srv)
+
+	var resp struct {
+		Todos []struct {
+			ID   int
+			User struct{ Name string }
+		}
+	}
+	c.MustPost(`{ todos { id user { name } } }`, &resp)
+	require.Equal(t, 3, len(resp.Todos))
+	assert.Equal(t, "alice", resp.Todos[0].User.Name)
+	assert.Equal(t, "bob", resp.Todos[1].User.Name)
+	assert.Equal(t, "alice", resp.Todos[2].User.Name)
+	require.Equal(t, 1, len(userRepo.Lookups))
+	assert.ElementsMatch(t, []any{alice, bob}, userRepo.Lookups[0])
+}
+
+func TestTodoTimestamps(t *testing.T) {
+	created := time.Date(2022, 12, 1, 10, 30, 0, 0, time.UTC)
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1, CreatedAt: created, UpdatedAt: created.Add(time.Hour)}, Title: "task 1"},
+		},
+	}}
+	r := newTestResolver(todoRepo, &testutil.MockRepo[model.User]{})
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(r.Config())))
+
+	var resp struct {
+		Todos []struct{ CreatedAt, UpdatedAt string }
+	}
+	c.MustPost(`{ todos { createdAt updatedAt } }`, &resp)
+	require.Equal(t, 1, len(resp.Todos))
+	assert.Equal(t, "2022-12-01T10:30:00Z", resp.Todos[0].CreatedAt)
+	assert.Equal(t, "2022-12-01T11:30:00Z", resp.Todos[0].UpdatedAt)
+}
+
+func TestInvalidScalarArgumentHasPath(t *testing.T) {
+	r := newTestResolver(&testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}, &testutil.MockRepo[model.User]{})
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(r.Config())))
+
+	for query, path := range map[string][]any{
+		`{ todos(filter: {createdAfter: "yesterday"}) { id } }`:           {"todos", "filter", "createdAfter"},
+		`{ todosConnection(after: "not a cursor") { edges { cursor } } }`: {"todosConnection", "after"},
+	} {
+		resp, err := c.RawPost(query)
+		require.NoError(t, err)
+		var errs []struct {
+			Message string
+			Path    []any
+		}
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		require.Equal(t, 1, len(errs), query)
+		assert.Equal(t, path, errs[0].Path, query)
+	}
+}
+
+func TestCreateTodoConstraintViolations(t *testing.T) {
+	r := newTestResolver(&testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}, &testutil.MockRepo[model.User]{})
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(r.Config()))
+	srv.Use(directive.Validation{})
+	c := client.New(srv)
+
+	resp, err := c.RawPost(`mutation { createTodo(input: {text: "", userId: "bob"}) { id } }`)
+	require.NoError(t, err)
+	var errs []struct {
+		Path       []any
+		Extensions struct {
+			Code       string
+			Violations []struct {
+				Path    []any
+				Message string
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+	require.Equal(t, 1, len(errs))
+	assert.Equal(t, []any{"createTodo"}, errs[0].Path)
+	assert.Equal(t, "VALIDATION", errs[0].Extensions.Code)
+	violations := errs[0].Extensions.Violations
+	require.Equal(t, 2, len(violations))
+	assert.ElementsMatch(t, []any{
+		[]any{"createTodo", "input", "text"},
+		[]any{"createTodo", "input", "userId"},
+	}, []any{violations[0].Path, violations[1].Path})
+}
+
+func TestAdminOnlyMutationsRejectAnonymousClients(t *testing.T) {
+	r := newTestResolver(&testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}, &testutil.MockRepo[model.User]{})
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(r.Config())))
+	for _, q := range []string{
+		`mutation { createUser(input: {name: "mallory"}) { id } }`,
+		`mutation { renameTag(id: 1, name: "mine") { id } }`,
+	} {
+		var resp any
+		err := c.Post(q, &resp)
+		require.Error(t, err, q)
+		assert.Contains(t, err.Error(), "authentication required", q)
+	}
+}
+
+func TestTodoCommentsAreBatched(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1"},
+			{Model: gorm.Model{ID: 2}, Title: "task 2"},
+		},
+	}}
+	commentRepo := &testutil.MockCommentRepo{MockRepo: &testutil.MockRepo[model.Comment]{
+		Models: []*model.Comment{
+			{ID: 5, TodoID: 1, Body: "first"},
+			{ID: 6, TodoID: 1, Body: "second"},
+			{ID: 7, TodoID: 2, Body: "only"},
+		},
+	}}
+	r := newTestResolver(todoRepo, &testutil.MockRepo[model.User]{})
+	r.commentSvc = service.NewServiceComment(commentRepo, r.todoSvc)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(r.Config()))
+	srv.Use(loader.Extension{New: r.NewLoaders})
+	c := client.New(srv)
+
+	var resp struct {
+		Todos []struct {
+			Comments struct {
+				Edges    []struct{ Node struct{ Body string } }
+				PageInfo struct{ HasNextPage bool }
+			}
+		}
+	}
+	c.MustPost(`{ todos { comments(first: 1) { edges { node { body } } pageInfo { hasNextPage } } } }`, &resp)
+	require.Equal(t, 2, len(resp.Todos))
+	require.Equal(t, 1, len(resp.Todos[0].Comments.Edges))
+	assert.Equal(t, "first", resp.Todos[0].Comments.Edges[0].Node.Body)
+	assert.True(t, resp.Todos[0].Comments.PageInfo.HasNextPage)
+	assert.Equal(t, "only", resp.Todos[1].Comments.Edges[0].Node.Body)
+	assert.False(t, resp.Todos[1].Comments.PageInfo.HasNextPage)
+	require.Equal(t, 1, len(commentRepo.FirstPageLookups))
+}
+
+func TestTodoAttachmentsAreBatched(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1"},
+			{Model: gorm.Model{ID: 2}, Title: "task 2"},
+		},
+	}}
+	attachmentRepo := &testutil.MockAttachmentRepo{MockRepo: &testutil.MockRepo[model.Attachment]{
+		Models: []*model.Attachment{
+			{ID: 1, TodoID: 1, Filename: "a.png"},
+			{ID: 2, TodoID: 1, Filename: "b.png"},
+		},
+	}}
+	r := newTestResolver(todoRepo, &testutil.MockRepo[model.User]{})
+	r.attachmentSvc = service.NewServiceAttachment(attachmentRepo, &testutil.MockStorage{}, storage.NewSigner([]byte("secret"), time.Minute), r.todoSvc, 0)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(r.Config()))
+	srv.Use(loader.Extension{New: r.NewLoaders})
+	c := client.New(srv)
+
+	var resp struct {
+		Todos []struct {
+			Attachments []struct{ Filename string }
+		}
+	}
+	c.MustPost(`{ todos { attachments { filename } } }`, &resp)
+	require.Equal(t, 2, len(resp.Todos))
+	require.Equal(t, 2, len(resp.Todos[0].Attachments))
+	assert.Equal(t, "b.png", resp.Todos[0].Attachments[1].Filename)
+	assert.Empty(t, resp.Todos[1].Attachments)
+	require.Equal(t, 1, len(attachmentRepo.TodoLookups))
+	assert.ElementsMatch(t, []uint{1, 2}, attachmentRepo.TodoLookups[0])
+}
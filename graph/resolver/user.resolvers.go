@@ -0,0 +1,41 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"go-graph/graph/generated"
+	"go-graph/graph/modelgen"
+)
+
+// CreateUser is the resolver for the createUser field.
+func (r *mutationResolver) CreateUser(ctx context.Context, input modelgen.NewUser) (*modelgen.User, error) {
+	return r.userSvc.NewUser(ctx, &input)
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context) ([]*modelgen.User, error) {
+	return r.userSvc.GetUsers(ctx)
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id int) (*modelgen.User, error) {
+	return r.userSvc.GetUser(ctx, id)
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*modelgen.User, error) {
+	return r.userSvc.GetMe(ctx)
+}
+
+// Todos is the resolver for the todos field.
+func (r *userResolver) Todos(ctx context.Context, obj *modelgen.User) ([]*modelgen.Todo, error) {
+	return r.todoSvc.GetTodos(ctx, &obj.ID, nil, nil)
+}
+
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
+type userResolver struct{ *Resolver }
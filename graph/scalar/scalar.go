@@ -0,0 +1,58 @@
+// Package scalar holds the marshalers of the custom GraphQL scalars declared
+// in graph/schema/scalars.gql.
+package scalar
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+var (
+	ErrInvalidDateTime = errors.New("DateTime must be an RFC3339 string such as 2022-12-01T10:30:00Z")
+	ErrInvalidCursor   = errors.New("Cursor must be a value returned by an earlier page")
+)
+
+// MarshalDateTime writes t as an RFC3339 string in UTC.
+func MarshalDateTime(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(t.UTC().Format(time.RFC3339Nano)))
+	})
+}
+
+// UnmarshalDateTime parses an RFC3339 string with an optional fraction of a
+// second. A zone offset is required so the instant is never ambiguous.
+func UnmarshalDateTime(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w, got %T", ErrInvalidDateTime, v)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w, got %q", ErrInvalidDateTime, s)
+	}
+	return t, nil
+}
+
+// MarshalCursor writes an opaque pagination cursor.
+func MarshalCursor(c string) graphql.Marshaler {
+	return graphql.MarshalString(c)
+}
+
+// UnmarshalCursor accepts the unpadded base64url strings handed out as
+// cursors. What they encode is up to the connection that issued them.
+func UnmarshalCursor(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%w, got %T", ErrInvalidCursor, v)
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(s); err != nil || s == "" {
+		return "", ErrInvalidCursor
+	}
+	return s, nil
+}
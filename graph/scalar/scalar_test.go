@@ -0,0 +1,40 @@
+package scalar
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalDateTime(t *testing.T) {
+	var buf bytes.Buffer
+	zone := time.FixedZone("UTC+7", 7*60*60)
+	MarshalDateTime(time.Date(2022, 12, 1, 17, 30, 0, 0, zone)).MarshalGQL(&buf)
+	assert.Equal(t, `"2022-12-01T10:30:00Z"`, buf.String())
+}
+
+func TestUnmarshalDateTime(t *testing.T) {
+	want := time.Date(2022, 12, 1, 10, 30, 0, 500, time.UTC)
+	for _, s := range []string{"2022-12-01T10:30:00.0000005Z", "2022-12-01T12:30:00.0000005+02:00"} {
+		got, err := UnmarshalDateTime(s)
+		require.NoError(t, err, s)
+		assert.True(t, want.Equal(got), s)
+	}
+	for _, v := range []any{"2022-12-01", "2022-12-01T10:30:00", "yesterday", 1669890600} {
+		_, err := UnmarshalDateTime(v)
+		assert.ErrorIs(t, err, ErrInvalidDateTime, v)
+	}
+}
+
+func TestUnmarshalCursor(t *testing.T) {
+	c, err := UnmarshalCursor("b2Zmc2V0OjE")
+	require.NoError(t, err)
+	assert.Equal(t, "b2Zmc2V0OjE", c)
+	for _, v := range []any{"", "not a cursor", "b2Zmc2V0OjE=", 1} {
+		_, err := UnmarshalCursor(v)
+		assert.ErrorIs(t, err, ErrInvalidCursor, v)
+	}
+}
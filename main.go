@@ -1,6 +1,10 @@
 package main
 
-import "go-graph/cmd"
+import (
+	"go-graph/cmd"
+	// recurring todos load IANA time zones, which the host may lack
+	_ "time/tzdata"
+)
 
 func main() {
 	cmd.Init(&cmd.AppInfo{
@@ -0,0 +1,63 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code is the machine readable error category exposed to clients as
+// extensions.code.
+type Code string
+
+const (
+	CodeNotFound        Code = "NOT_FOUND"
+	CodeValidation      Code = "VALIDATION"
+	CodeConflict        Code = "CONFLICT"
+	CodeUnauthenticated Code = "UNAUTHENTICATED"
+	CodeForbidden       Code = "FORBIDDEN"
+	CodeInternal        Code = "INTERNAL"
+)
+
+// Error is a domain error whose message is safe to show to clients. Cause
+// keeps the underlying error for logging only; Details are shown to clients
+// as extensions next to the code.
+type Error struct {
+	Code    Code
+	Message string
+	Cause   error
+	Details map[string]any
+}
+
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func Errorf(code Code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Internal wraps an unexpected error. Its message is never shown to clients.
+func Internal(cause error) *Error {
+	return &Error{Code: CodeInternal, Message: "internal server error", Cause: cause}
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return e.Message + ": " + e.Cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// CodeOf returns the code of the first *Error in the chain of err, or
+// CodeInternal when there is none.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeInternal
+}
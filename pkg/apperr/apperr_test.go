@@ -0,0 +1,24 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeOf(t *testing.T) {
+	notFound := New(CodeNotFound, "todo not found")
+	assert.Equal(t, CodeNotFound, CodeOf(notFound))
+	assert.Equal(t, CodeValidation, CodeOf(fmt.Errorf("%w: text must not be empty", New(CodeValidation, "invalid input"))))
+	assert.Equal(t, CodeInternal, CodeOf(errors.New("pq: connection refused")))
+	assert.Equal(t, CodeInternal, CodeOf(nil))
+}
+
+func TestInternal(t *testing.T) {
+	cause := errors.New("pq: connection refused")
+	err := Internal(cause)
+	assert.Equal(t, "internal server error", err.Message)
+	assert.ErrorIs(t, err, cause)
+}
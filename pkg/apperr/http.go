@@ -0,0 +1,20 @@
+package apperr
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// WriteHTTP answers a request rejected before it reached the GraphQL
+// handler in the GraphQL response format, so clients can handle the error
+// like any other.
+func WriteHTTP(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{
+			"message":    err.Error(),
+			"extensions": map[string]any{"code": string(CodeOf(err))},
+		}},
+	})
+}
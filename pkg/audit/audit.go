@@ -0,0 +1,18 @@
+package audit
+
+import "context"
+
+type ctxKey struct{}
+
+// WithActor records who is acting on behalf of the request so writes made
+// with ctx are attributed to them in the audit log.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or an empty string
+// for anonymous requests and background jobs.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(ctxKey{}).(string)
+	return actor
+}
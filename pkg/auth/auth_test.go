@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"go-graph/pkg/audit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func liveClaims() map[string]any {
+	return map[string]any{"sub": "7", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func TestMiddleware(t *testing.T) {
+	var (
+		seen   *Principal
+		actor  string
+		client bool
+	)
+	h := Middleware(NewVerifier(WithHS256(secret)), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen, actor, client = FromContext(r.Context()), audit.ActorFromContext(r.Context()), IsClient(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/query", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, seen)
+	assert.True(t, client)
+
+	r.Header.Set("Authorization", "Bearer "+signHS256(t, secret, liveClaims()))
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	require.NotNil(t, seen)
+	assert.Equal(t, uint(7), seen.UserID)
+	assert.Equal(t, "7", actor)
+
+	seen = nil
+	r.Header.Set("Authorization", "Bearer "+signHS256(t, []byte("other"), liveClaims()))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"UNAUTHENTICATED"`)
+	assert.Nil(t, seen)
+}
+
+func TestWebsocketInit(t *testing.T) {
+	init := WebsocketInit(NewVerifier(WithHS256(secret)))
+
+	ctx, err := init(context.Background(), transport.InitPayload{})
+	require.NoError(t, err)
+	assert.Nil(t, FromContext(ctx))
+
+	ctx, err = init(context.Background(), transport.InitPayload{"Authorization": "Bearer " + signHS256(t, secret, liveClaims())})
+	require.NoError(t, err)
+	assert.Equal(t, "7", FromContext(ctx).Subject)
+
+	_, err = init(context.Background(), transport.InitPayload{"Authorization": "Bearer nope"})
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestRequire(t *testing.T) {
+	_, err := Require(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+	p, err := Require(WithPrincipal(context.Background(), &Principal{Subject: "7"}))
+	require.NoError(t, err)
+	assert.Equal(t, "7", p.Subject)
+}
@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go-graph/pkg/apperr"
+	"strings"
+	"time"
+)
+
+var ErrInvalidToken = apperr.New(apperr.CodeUnauthenticated, "invalid token")
+
+// leeway absorbs clock skew between the issuer and this server.
+const leeway = 30 * time.Second
+
+// Verifier checks compact JWS tokens signed with HS256 or RS256. Only the
+// algorithms with a configured key are accepted, so a token can never pick
+// a weaker one for itself.
+type Verifier struct {
+	secret   []byte
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+}
+
+type VerifierOption func(*Verifier)
+
+// WithHS256 accepts tokens signed with the shared secret.
+func WithHS256(secret []byte) VerifierOption {
+	return func(v *Verifier) { v.secret = secret }
+}
+
+// WithRS256 accepts tokens signed by any of keys, looked up by kid.
+func WithRS256(keys map[string]*rsa.PublicKey) VerifierOption {
+	return func(v *Verifier) { v.keys = keys }
+}
+
+// WithIssuer requires the iss claim to equal issuer.
+func WithIssuer(issuer string) VerifierOption {
+	return func(v *Verifier) { v.issuer = issuer }
+}
+
+// WithAudience requires the aud claim to contain audience.
+func WithAudience(audience string) VerifierOption {
+	return func(v *Verifier) { v.audience = audience }
+}
+
+func NewVerifier(opts ...VerifierOption) *Verifier {
+	v := &Verifier{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	Roles     []string `json:"roles"`
+	Tenant    string   `json:"tenant"`
+}
+
+// audience accepts both forms of the aud claim, a string and a list.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
+// Verify checks the signature and the registered claims of token at now and
+// returns the principal it was issued to. exp and sub are required.
+func (v *Verifier) Verify(token string, now time.Time) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+	if err := v.verifySignature(h, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, err
+	}
+	if err := v.checkClaims(&c, now); err != nil {
+		return nil, err
+	}
+	return newPrincipal(&c), nil
+}
+
+func (v *Verifier) verifySignature(h header, signed string, sig []byte) error {
+	switch {
+	case h.Alg == "HS256" && v.secret != nil:
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(sig, mac.Sum(nil)) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	case h.Alg == "RS256" && len(v.keys) > 0:
+		key, ok := v.keys[h.Kid]
+		if !ok {
+			return fmt.Errorf("%w: unknown key %q", ErrInvalidToken, h.Kid)
+		}
+		digest := sha256.Sum256([]byte(signed))
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, h.Alg)
+	}
+}
+
+func (v *Verifier) checkClaims(c *claims, now time.Time) error {
+	if c.Subject == "" {
+		return fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+	if c.ExpiresAt == 0 {
+		return fmt.Errorf("%w: missing expiry", ErrInvalidToken)
+	}
+	if now.Add(-leeway).After(time.Unix(c.ExpiresAt, 0)) {
+		return fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+	if c.NotBefore != 0 && now.Add(leeway).Before(time.Unix(c.NotBefore, 0)) {
+		return fmt.Errorf("%w: token not valid yet", ErrInvalidToken)
+	}
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+	if v.audience != "" && !c.Audience.contains(v.audience) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	return nil
+}
+
+func (a audience) contains(s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(seg string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	secret = []byte("test-secret")
+	now    = time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+)
+
+func segment(t *testing.T, v any) string {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signHS256(t *testing.T, key []byte, claims map[string]any) string {
+	signed := segment(t, map[string]any{"alg": "HS256", "typ": "JWT"}) + "." + segment(t, claims)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	signed := segment(t, map[string]any{"alg": "RS256", "kid": kid}) + "." + segment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims() map[string]any {
+	return map[string]any{"sub": "7", "exp": now.Add(time.Hour).Unix(), "roles": []string{"ADMIN"}}
+}
+
+func TestVerifyHS256(t *testing.T) {
+	v := NewVerifier(WithHS256(secret))
+	p, err := v.Verify(signHS256(t, secret, validClaims()), now)
+	require.NoError(t, err)
+	assert.Equal(t, "7", p.Subject)
+	assert.Equal(t, uint(7), p.UserID)
+	assert.True(t, p.HasRole("ADMIN"))
+}
+
+func TestVerifyRejects(t *testing.T) {
+	v := NewVerifier(WithHS256(secret), WithIssuer("todo-auth"), WithAudience("todo-api"))
+	claims := func(change func(map[string]any)) map[string]any {
+		c := validClaims()
+		c["iss"], c["aud"] = "todo-auth", []string{"other", "todo-api"}
+		change(c)
+		return c
+	}
+	_, err := v.Verify(signHS256(t, secret, claims(func(map[string]any) {})), now)
+	require.NoError(t, err)
+
+	unsigned := segment(t, map[string]any{"alg": "none"}) + "." + segment(t, validClaims()) + "."
+	tests := map[string]string{
+		"wrong key":      signHS256(t, []byte("other"), claims(func(map[string]any) {})),
+		"alg none":       unsigned,
+		"malformed":      "a.b",
+		"expired":        signHS256(t, secret, claims(func(c map[string]any) { c["exp"] = now.Add(-time.Minute).Unix() })),
+		"no expiry":      signHS256(t, secret, claims(func(c map[string]any) { delete(c, "exp") })),
+		"not yet valid":  signHS256(t, secret, claims(func(c map[string]any) { c["nbf"] = now.Add(time.Minute).Unix() })),
+		"no subject":     signHS256(t, secret, claims(func(c map[string]any) { delete(c, "sub") })),
+		"wrong issuer":   signHS256(t, secret, claims(func(c map[string]any) { c["iss"] = "evil" })),
+		"wrong audience": signHS256(t, secret, claims(func(c map[string]any) { c["aud"] = "other" })),
+	}
+	for name, token := range tests {
+		_, err := v.Verify(token, now)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+}
+
+func TestVerifyRS256WithJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks := map[string]any{"keys": []map[string]any{
+		{"kty": "EC", "kid": "ec", "crv": "P-256"},
+		{
+			"kty": "RSA", "kid": "k1", "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		},
+	}}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	b, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+
+	keys, err := LoadJWKS(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	v := NewVerifier(WithRS256(keys))
+
+	p, err := v.Verify(signRS256(t, key, "k1", validClaims()), now)
+	require.NoError(t, err)
+	assert.Equal(t, "7", p.Subject)
+
+	_, err = v.Verify(signRS256(t, key, "k2", validClaims()), now)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	// HS256 is not configured, so a token signed with the public modulus as
+	// secret must not get through either.
+	_, err = v.Verify(signHS256(t, key.N.Bytes(), validClaims()), now)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
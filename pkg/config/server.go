@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
@@ -11,11 +12,41 @@ import (
 type ServerConfig interface {
 	GetLogLevel() string
 	GetPort() string
+	GetSubscriptionBuffer() int
+	GetLoaderWait() time.Duration
+	GetLoaderMaxBatch() int
+	GetTrashRetention() time.Duration
+	GetReminderInterval() time.Duration
+	GetRecurrenceInterval() time.Duration
+	GetJWTSecret() string
+	GetJWKSFile() string
+	GetJWTIssuer() string
+	GetJWTAudience() string
+	GetUploadMaxSize() int64
+	GetUploadMaxMemory() int64
+	GetStorageDir() string
+	GetDownloadSecret() string
+	GetDownloadTTL() time.Duration
 }
 
 type serverConfig struct {
-	LogLevel string `mapstructure:"log-level"`
-	Port     string `mapstructure:"port"`
+	LogLevel           string `mapstructure:"log-level"`
+	Port               string `mapstructure:"port"`
+	SubscriptionBuffer int    `mapstructure:"subscription-buffer"` // events buffered per subscriber
+	LoaderWait         int    `mapstructure:"loader-wait"`         // time is millisecond
+	LoaderMaxBatch     int    `mapstructure:"loader-max-batch"`
+	TrashRetention     int    `mapstructure:"trash-retention"`     // time is day, 0 keeps deleted rows forever
+	ReminderInterval   int    `mapstructure:"reminder-interval"`   // time is second, 0 disables reminders
+	RecurrenceInterval int    `mapstructure:"recurrence-interval"` // time is second, 0 spawns recurring todos on completion only
+	JWTSecret          string `mapstructure:"jwt-secret"`          // HS256 key, empty disables HS256
+	JWKSFile           string `mapstructure:"jwks-file"`           // RS256 keys, empty disables RS256
+	JWTIssuer          string `mapstructure:"jwt-issuer"`          // required iss claim, empty accepts any
+	JWTAudience        string `mapstructure:"jwt-audience"`        // required aud claim, empty accepts any
+	UploadMaxSize      int64  `mapstructure:"upload-max-size"`     // size is megabyte, per multipart request
+	UploadMaxMemory    int64  `mapstructure:"upload-max-memory"`   // size is megabyte, larger uploads spill to disk
+	StorageDir         string `mapstructure:"storage-dir"`         // directory of attachment files
+	DownloadSecret     string `mapstructure:"download-secret"`     // signs download links, empty picks one per run
+	DownloadTTL        int    `mapstructure:"download-ttl"`        // time is second
 }
 
 var config *serverConfig
@@ -35,6 +66,66 @@ func (c *serverConfig) GetPort() string {
 	return c.Port
 }
 
+func (c *serverConfig) GetSubscriptionBuffer() int {
+	return c.SubscriptionBuffer
+}
+
+func (c *serverConfig) GetLoaderWait() time.Duration {
+	return time.Duration(c.LoaderWait) * time.Millisecond
+}
+
+func (c *serverConfig) GetLoaderMaxBatch() int {
+	return c.LoaderMaxBatch
+}
+
+func (c *serverConfig) GetTrashRetention() time.Duration {
+	return time.Duration(c.TrashRetention) * 24 * time.Hour
+}
+
+func (c *serverConfig) GetReminderInterval() time.Duration {
+	return time.Duration(c.ReminderInterval) * time.Second
+}
+
+func (c *serverConfig) GetRecurrenceInterval() time.Duration {
+	return time.Duration(c.RecurrenceInterval) * time.Second
+}
+
+func (c *serverConfig) GetJWTSecret() string {
+	return c.JWTSecret
+}
+
+func (c *serverConfig) GetJWKSFile() string {
+	return c.JWKSFile
+}
+
+func (c *serverConfig) GetJWTIssuer() string {
+	return c.JWTIssuer
+}
+
+func (c *serverConfig) GetJWTAudience() string {
+	return c.JWTAudience
+}
+
+func (c *serverConfig) GetUploadMaxSize() int64 {
+	return c.UploadMaxSize << 20
+}
+
+func (c *serverConfig) GetUploadMaxMemory() int64 {
+	return c.UploadMaxMemory << 20
+}
+
+func (c *serverConfig) GetStorageDir() string {
+	return c.StorageDir
+}
+
+func (c *serverConfig) GetDownloadSecret() string {
+	return c.DownloadSecret
+}
+
+func (c *serverConfig) GetDownloadTTL() time.Duration {
+	return time.Duration(c.DownloadTTL) * time.Second
+}
+
 func InitDefaultServerConfig() error {
 	return InitServerConfig(false, "")
 }
@@ -0,0 +1,170 @@
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BatchFunc fetches values for keys in one round trip. Both slices must be
+// aligned with keys; errs may be nil when every key succeeded, or hold a
+// single error that applies to the whole batch.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (values []V, errs []error)
+
+// Loader collects the keys requested within Wait, or until MaxBatch keys are
+// pending, and resolves them with a single BatchFunc call. Successful results
+// are cached for the lifetime of the loader, which is meant to be one request.
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	cache   map[K]*result[V]
+	pending *batch[K, V]
+}
+
+type result[V any] struct {
+	value V
+	err   error
+	done  chan struct{}
+}
+
+type batch[K comparable, V any] struct {
+	keys       []K
+	results    []*result[V]
+	dispatched bool
+}
+
+func New[K comparable, V any](fetch BatchFunc[K, V], wait time.Duration, maxBatch int) *Loader[K, V] {
+	if maxBatch <= 0 {
+		maxBatch = 100
+	}
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		cache:    make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value for key, batching the lookup with every other key
+// requested in the same window.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	r, ok := l.cache[key]
+	if !ok {
+		r = &result[V]{done: make(chan struct{})}
+		l.cache[key] = r
+		l.enqueue(ctx, key, r)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// LoadAll loads every key and returns values and errors aligned with keys.
+func (l *Loader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, []error) {
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key K) {
+			defer wg.Done()
+			values[i], errs[i] = l.Load(ctx, key)
+		}(i, key)
+	}
+	wg.Wait()
+	return values, errs
+}
+
+// Prime stores value for key unless it is already cached.
+func (l *Loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.cache[key]; ok {
+		return
+	}
+	r := &result[V]{value: value, done: make(chan struct{})}
+	close(r.done)
+	l.cache[key] = r
+}
+
+// Clear drops key from the cache so the next Load fetches it again.
+func (l *Loader[K, V]) Clear(key K) {
+	l.mu.Lock()
+	delete(l.cache, key)
+	l.mu.Unlock()
+}
+
+// enqueue adds key to the pending batch; l.mu must be held.
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, r *result[V]) {
+	if l.pending == nil {
+		b := &batch[K, V]{}
+		l.pending = b
+		time.AfterFunc(l.wait, func() {
+			l.mu.Lock()
+			if b.dispatched {
+				l.mu.Unlock()
+				return
+			}
+			l.detach(b)
+			l.mu.Unlock()
+			l.run(ctx, b)
+		})
+	}
+	b := l.pending
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, r)
+	if len(b.keys) >= l.maxBatch {
+		l.detach(b)
+		go l.run(ctx, b)
+	}
+}
+
+// detach marks b as dispatched so no more keys join it; l.mu must be held.
+func (l *Loader[K, V]) detach(b *batch[K, V]) {
+	b.dispatched = true
+	if l.pending == b {
+		l.pending = nil
+	}
+}
+
+func (l *Loader[K, V]) run(ctx context.Context, b *batch[K, V]) {
+	values, errs := l.fetch(ctx, b.keys)
+	for i, r := range b.results {
+		switch {
+		case len(errs) == 1 && len(b.keys) != 1:
+			r.err = errs[0]
+		case errs != nil && len(errs) != len(b.keys):
+			r.err = fmt.Errorf("dataloader: batch returned %d errors for %d keys", len(errs), len(b.keys))
+		case errs != nil && errs[i] != nil:
+			r.err = errs[i]
+		case len(values) != len(b.keys):
+			r.err = fmt.Errorf("dataloader: batch returned %d values for %d keys", len(values), len(b.keys))
+		default:
+			r.value = values[i]
+		}
+		if r.err != nil {
+			l.forget(b.keys[i], r)
+		}
+		close(r.done)
+	}
+}
+
+// forget drops a failed result so the key is fetched again on the next Load.
+func (l *Loader[K, V]) forget(key K, r *result[V]) {
+	l.mu.Lock()
+	if l.cache[key] == r {
+		delete(l.cache, key)
+	}
+	l.mu.Unlock()
+}
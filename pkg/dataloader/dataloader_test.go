@@ -0,0 +1,98 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMissing = errors.New("missing")
+
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+// fetch doubles every key and reports negative keys as missing.
+func (r *recorder) fetch(ctx context.Context, keys []int) ([]int, []error) {
+	r.mu.Lock()
+	r.batches = append(r.batches, append([]int(nil), keys...))
+	r.mu.Unlock()
+	values := make([]int, len(keys))
+	errs := make([]error, len(keys))
+	for i, k := range keys {
+		if k < 0 {
+			errs[i] = errMissing
+			continue
+		}
+		values[i] = k * 2
+	}
+	return values, errs
+}
+
+func TestLoadAllBatchesInOrder(t *testing.T) {
+	rec := &recorder{}
+	l := New(rec.fetch, 5*time.Millisecond, 100)
+	values, errs := l.LoadAll(context.Background(), []int{3, -1, 1, 3})
+	assert.Equal(t, []int{6, 0, 2, 6}, values)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], errMissing)
+	assert.NoError(t, errs[2])
+	assert.NoError(t, errs[3])
+	require.Equal(t, 1, len(rec.batches))
+	assert.ElementsMatch(t, []int{3, -1, 1}, rec.batches[0])
+}
+
+func TestMaxBatch(t *testing.T) {
+	rec := &recorder{}
+	l := New(rec.fetch, time.Hour, 2)
+	values, errs := l.LoadAll(context.Background(), []int{1, 2, 3, 4})
+	assert.Equal(t, []int{2, 4, 6, 8}, values)
+	assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+	assert.Equal(t, 2, len(rec.batches))
+}
+
+func TestCache(t *testing.T) {
+	rec := &recorder{}
+	l := New(rec.fetch, time.Millisecond, 100)
+	ctx := context.Background()
+	v, err := l.Load(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	v, err = l.Load(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 1, len(rec.batches))
+
+	l.Prime(5, 50)
+	v, err = l.Load(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 50, v)
+	assert.Equal(t, 1, len(rec.batches))
+}
+
+func TestErrorsAreNotCached(t *testing.T) {
+	rec := &recorder{}
+	l := New(rec.fetch, time.Millisecond, 100)
+	ctx := context.Background()
+	_, err := l.Load(ctx, -1)
+	assert.ErrorIs(t, err, errMissing)
+	_, err = l.Load(ctx, -1)
+	assert.ErrorIs(t, err, errMissing)
+	assert.Equal(t, 2, len(rec.batches))
+}
+
+func TestBatchError(t *testing.T) {
+	boom := errors.New("boom")
+	l := New(func(ctx context.Context, keys []int) ([]int, []error) {
+		return nil, []error{boom}
+	}, time.Millisecond, 100)
+	_, errs := l.LoadAll(context.Background(), []int{1, 2})
+	assert.ErrorIs(t, errs[0], boom)
+	assert.ErrorIs(t, errs[1], boom)
+}
@@ -0,0 +1,83 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Broker fans out published messages to in-process subscribers. Each
+// subscriber owns a bounded buffer; when it is full the message is dropped
+// for that subscriber so a slow reader never blocks Publish.
+type Broker[T any] struct {
+	mu         sync.RWMutex
+	subs       map[*subscriber[T]]struct{}
+	bufferSize int
+	dropped    atomic.Uint64
+}
+
+type subscriber[T any] struct {
+	ch     chan T
+	filter func(T) bool
+}
+
+func NewBroker[T any](bufferSize int) *Broker[T] {
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	return &Broker[T]{
+		subs:       make(map[*subscriber[T]]struct{}),
+		bufferSize: bufferSize,
+	}
+}
+
+// Subscribe returns a channel receiving every published message accepted by
+// filter, or every message when filter is nil. The channel is closed once
+// ctx is done.
+func (b *Broker[T]) Subscribe(ctx context.Context, filter func(T) bool) <-chan T {
+	s := &subscriber[T]{
+		ch:     make(chan T, b.bufferSize),
+		filter: filter,
+	}
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, s)
+		close(s.ch)
+		b.mu.Unlock()
+	}()
+	return s.ch
+}
+
+// Publish delivers msg to every matching subscriber without blocking.
+func (b *Broker[T]) Publish(msg T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for s := range b.subs {
+		if s.filter != nil && !s.filter(msg) {
+			continue
+		}
+		select {
+		case s.ch <- msg:
+		default:
+			b.dropped.Add(1)
+		}
+	}
+}
+
+// Subscribers returns the number of active subscriptions.
+func (b *Broker[T]) Subscribers() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs)
+}
+
+// Dropped returns how many deliveries were skipped because a subscriber's
+// buffer was full.
+func (b *Broker[T]) Dropped() uint64 {
+	return b.dropped.Load()
+}
@@ -0,0 +1,63 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishSubscribe(t *testing.T) {
+	b := NewBroker[int](4)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	even := b.Subscribe(ctx, func(v int) bool { return v%2 == 0 })
+	all := b.Subscribe(ctx, nil)
+
+	for i := 1; i <= 4; i++ {
+		b.Publish(i)
+	}
+	assert.Equal(t, 2, <-even)
+	assert.Equal(t, 4, <-even)
+	for i := 1; i <= 4; i++ {
+		assert.Equal(t, i, <-all)
+	}
+}
+
+func TestSlowSubscriberDoesNotBlock(t *testing.T) {
+	b := NewBroker[int](2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := b.Subscribe(ctx, nil)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			b.Publish(i)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publish blocked on a full subscriber")
+	}
+	assert.Equal(t, 0, <-ch)
+	assert.Equal(t, 1, <-ch)
+	assert.Equal(t, uint64(8), b.Dropped())
+}
+
+func TestUnsubscribeOnCancel(t *testing.T) {
+	b := NewBroker[int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := b.Subscribe(ctx, nil)
+	require.Equal(t, 1, b.Subscribers())
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+	assert.Equal(t, 0, b.Subscribers())
+	b.Publish(1)
+}
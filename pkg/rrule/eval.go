@@ -0,0 +1,268 @@
+package rrule
+
+import (
+	"time"
+)
+
+// maxEmptyPeriods stops the evaluation of rules that never match, e.g.
+// February 30th, after this many periods in a row without an occurrence.
+// It is enough for every rule that does match, such as February 29th.
+const maxEmptyPeriods = 3000
+
+// Iterator yields the occurrences of a rule in order.
+type Iterator struct {
+	r       *Rule
+	start   time.Time
+	loc     *time.Location
+	until   time.Time
+	period  int
+	pending []time.Time
+	count   int
+	done    bool
+}
+
+// Iterator evaluates r from start. Occurrences keep the wall clock time of
+// start in its time zone, so a daily 9:00 stays at 9:00 across DST
+// transitions. Start itself is only an occurrence when it matches the rule.
+func (r *Rule) Iterator(start time.Time) *Iterator {
+	it := &Iterator{r: r, start: start, loc: start.Location()}
+	switch r.until {
+	case untilUTC:
+		it.until = r.Until
+	case untilLocal:
+		it.until = localTime(r.Until, it.loc)
+	case untilDate:
+		y, m, d := r.Until.Date()
+		it.until = localTime(time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC), it.loc).Add(-time.Nanosecond)
+	}
+	return it
+}
+
+// Next returns the next occurrence; ok is false once the rule is exhausted.
+func (it *Iterator) Next() (t time.Time, ok bool) {
+	for empty := 0; len(it.pending) == 0; empty++ {
+		if it.done || empty >= maxEmptyPeriods {
+			it.done = true
+			return time.Time{}, false
+		}
+		it.fill()
+	}
+	t, it.pending = it.pending[0], it.pending[1:]
+	if it.r.HasUntil() && t.After(it.until) {
+		it.done, it.pending = true, nil
+		return time.Time{}, false
+	}
+	it.count++
+	if it.r.Count > 0 && it.count >= it.r.Count {
+		it.done, it.pending = true, nil
+	}
+	return t, true
+}
+
+// fill queues the occurrences of the next period.
+func (it *Iterator) fill() {
+	dates := it.r.expand(it.start, it.period)
+	it.period++
+	if len(dates) > 0 && dates[0].Year() > 9999 {
+		it.done = true
+		return
+	}
+	h, m, s := it.start.Clock()
+	for _, d := range dates {
+		y, mo, day := d.Date()
+		t := localTime(time.Date(y, mo, day, h, m, s, it.start.Nanosecond(), time.UTC), it.loc)
+		if !t.Before(it.start) {
+			it.pending = append(it.pending, t)
+		}
+	}
+}
+
+// After returns the first occurrence of r from start that is later than t.
+func (r *Rule) After(start, t time.Time) (time.Time, bool) {
+	it := r.Iterator(start)
+	for {
+		next, ok := it.Next()
+		if !ok || next.After(t) {
+			return next, ok
+		}
+	}
+}
+
+// Following returns up to n occurrences of r from start that are later
+// than t.
+func (r *Rule) Following(start, t time.Time, n int) []time.Time {
+	var res []time.Time
+	it := r.Iterator(start)
+	for len(res) < n {
+		next, ok := it.Next()
+		if !ok {
+			break
+		}
+		if next.After(t) {
+			res = append(res, next)
+		}
+	}
+	return res
+}
+
+// expand returns the civil dates, as UTC midnights, of the given period of
+// the rule started at start.
+func (r *Rule) expand(start time.Time, period int) []time.Time {
+	y, m, d := start.Date()
+	n := period * r.Interval
+	var dates []time.Time
+	switch r.Freq {
+	case Daily:
+		day := time.Date(y, m, d+n, 0, 0, 0, 0, time.UTC)
+		if r.matchMonth(day.Month()) && r.matchMonthDay(day) && r.matchWeekday(day.Weekday()) {
+			dates = append(dates, day)
+		}
+	case Weekly:
+		offset := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		for i := 0; i < 7; i++ {
+			day := time.Date(y, m, d-offset+7*n+i, 0, 0, 0, 0, time.UTC)
+			if !r.matchMonth(day.Month()) {
+				continue
+			}
+			if len(r.ByDay) == 0 && day.Weekday() == start.Weekday() || len(r.ByDay) > 0 && r.matchWeekday(day.Weekday()) {
+				dates = append(dates, day)
+			}
+		}
+	case Monthly:
+		first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+		if r.matchMonth(first.Month()) {
+			dates = r.monthDates(first.Year(), first.Month(), d)
+		}
+	case Yearly:
+		year := y + n
+		if len(r.ByDay) > 0 && len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 {
+			// numbered weekdays count through the whole year
+			return weekdayDates(r.ByDay, time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC))
+		}
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{m}
+			if len(r.ByMonthDay) > 0 {
+				months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			}
+		}
+		for _, month := range months {
+			dates = append(dates, r.monthDates(year, month, d)...)
+		}
+	}
+	return sortDates(dates)
+}
+
+// monthDates expands BYMONTHDAY and BYDAY within a month. Without either
+// the rule repeats on day; months too short for it are skipped.
+func (r *Rule) monthDates(y int, m time.Month, day int) []time.Time {
+	first := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	next := first.AddDate(0, 1, 0)
+	days := int(next.Sub(first).Hours() / 24)
+	var dates []time.Time
+	if len(r.ByDay) > 0 {
+		for _, d := range weekdayDates(r.ByDay, first, next) {
+			if len(r.ByMonthDay) == 0 || r.matchMonthDay(d) {
+				dates = append(dates, d)
+			}
+		}
+		return dates
+	}
+	if len(r.ByMonthDay) > 0 {
+		for _, md := range r.ByMonthDay {
+			if md < 0 {
+				md += days + 1
+			}
+			if md >= 1 && md <= days {
+				dates = append(dates, time.Date(y, m, md, 0, 0, 0, 0, time.UTC))
+			}
+		}
+		return dates
+	}
+	if day <= days {
+		dates = append(dates, time.Date(y, m, day, 0, 0, 0, 0, time.UTC))
+	}
+	return dates
+}
+
+// weekdayDates lists the days from first up to before next matching byDay.
+// Numbered entries pick the Nth such weekday of that span.
+func weekdayDates(byDay []WeekdayNum, first, next time.Time) []time.Time {
+	var dates []time.Time
+	for _, w := range byDay {
+		var matching []time.Time
+		offset := (int(w.Weekday) - int(first.Weekday()) + 7) % 7
+		for d := first.AddDate(0, 0, offset); d.Before(next); d = d.AddDate(0, 0, 7) {
+			matching = append(matching, d)
+		}
+		switch {
+		case w.N == 0:
+			dates = append(dates, matching...)
+		case w.N > 0 && w.N <= len(matching):
+			dates = append(dates, matching[w.N-1])
+		case w.N < 0 && -w.N <= len(matching):
+			dates = append(dates, matching[len(matching)+w.N])
+		}
+	}
+	return dates
+}
+
+func (r *Rule) matchMonth(m time.Month) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, v := range r.ByMonth {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+// matchMonthDay checks day against BYMONTHDAY, negative entries counting
+// from the end of its month.
+func (r *Rule) matchMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	days := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, md := range r.ByMonthDay {
+		if md == day.Day() || md < 0 && days+md+1 == day.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchWeekday(w time.Weekday) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, v := range r.ByDay {
+		if v.Weekday == w {
+			return true
+		}
+	}
+	return false
+}
+
+// localTime returns the instant at which the clocks of loc show the wall
+// clock time of wall, which is given in UTC. As RFC 5545 requires, a time
+// skipped by a DST transition is read with the offset in effect before the
+// transition, and a time repeated by one means its first instance.
+func localTime(wall time.Time, loc *time.Location) time.Time {
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+	first := wall.Add(-time.Duration(before) * time.Second).In(loc)
+	if sameWallClock(first, wall) {
+		return first
+	}
+	if second := wall.Add(-time.Duration(after) * time.Second).In(loc); sameWallClock(second, wall) {
+		return second
+	}
+	return first
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).Equal(wall)
+}
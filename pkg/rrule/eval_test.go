@@ -0,0 +1,171 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+// occurrences lists up to n occurrences of rule from start, formatted in
+// the time zone of start.
+func occurrences(t *testing.T, rule string, start time.Time, n int) []string {
+	r, err := Parse(rule)
+	require.NoError(t, err)
+	var res []string
+	it := r.Iterator(start)
+	for len(res) < n {
+		next, ok := it.Next()
+		if !ok {
+			break
+		}
+		res = append(res, next.Format("2006-01-02 15:04 MST Mon"))
+	}
+	return res
+}
+
+func TestIterator(t *testing.T) {
+	// Monday, 2 January 2023
+	start := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		want  []string
+		// ends marks rules with no occurrences after want
+		ends bool
+	}{
+		{
+			name: "daily interval", rule: "FREQ=DAILY;INTERVAL=3", start: start,
+			want: []string{"2023-01-02 09:00 UTC Mon", "2023-01-05 09:00 UTC Thu", "2023-01-08 09:00 UTC Sun"},
+		},
+		{
+			name: "weekly on start weekday", rule: "FREQ=WEEKLY;COUNT=2", start: start,
+			want: []string{"2023-01-02 09:00 UTC Mon", "2023-01-09 09:00 UTC Mon"},
+		},
+		{
+			name: "biweekly on several days", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,FR", start: start,
+			want: []string{"2023-01-03 09:00 UTC Tue", "2023-01-06 09:00 UTC Fri", "2023-01-17 09:00 UTC Tue", "2023-01-20 09:00 UTC Fri"},
+		},
+		{
+			name: "week start decides the week", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SU;WKST=SU", start: time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC),
+			want: []string{"2023-01-15 09:00 UTC Sun", "2023-01-16 09:00 UTC Mon", "2023-01-29 09:00 UTC Sun"},
+		},
+		{
+			name: "monthly skips short months", rule: "FREQ=MONTHLY", start: time.Date(2023, 1, 31, 9, 0, 0, 0, time.UTC),
+			want: []string{"2023-01-31 09:00 UTC Tue", "2023-03-31 09:00 UTC Fri", "2023-05-31 09:00 UTC Wed"},
+		},
+		{
+			name: "last day of month", rule: "FREQ=MONTHLY;BYMONTHDAY=-1", start: start,
+			want: []string{"2023-01-31 09:00 UTC Tue", "2023-02-28 09:00 UTC Tue", "2023-03-31 09:00 UTC Fri"},
+		},
+		{
+			name: "last friday", rule: "FREQ=MONTHLY;BYDAY=-1FR", start: start,
+			want: []string{"2023-01-27 09:00 UTC Fri", "2023-02-24 09:00 UTC Fri", "2023-03-31 09:00 UTC Fri"},
+		},
+		{
+			name: "friday the 13th", rule: "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", start: start,
+			want: []string{"2023-01-13 09:00 UTC Fri", "2023-10-13 09:00 UTC Fri", "2024-09-13 09:00 UTC Fri"},
+		},
+		{
+			name: "leap day", rule: "FREQ=YEARLY", start: time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+			want: []string{"2024-02-29 09:00 UTC Thu", "2028-02-29 09:00 UTC Tue"},
+		},
+		{
+			name: "thanksgiving", rule: "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH", start: start,
+			want: []string{"2023-11-23 09:00 UTC Thu", "2024-11-28 09:00 UTC Thu"},
+		},
+		{
+			name: "first monday of the year", rule: "FREQ=YEARLY;BYDAY=1MO", start: time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC),
+			want: []string{"2024-01-01 09:00 UTC Mon", "2025-01-06 09:00 UTC Mon"},
+		},
+		{
+			name: "until is inclusive", rule: "FREQ=DAILY;UNTIL=20230104T090000Z", start: start,
+			want: []string{"2023-01-02 09:00 UTC Mon", "2023-01-03 09:00 UTC Tue", "2023-01-04 09:00 UTC Wed"},
+			ends: true,
+		},
+		{
+			name: "until date", rule: "FREQ=DAILY;UNTIL=20230103", start: start,
+			want: []string{"2023-01-02 09:00 UTC Mon", "2023-01-03 09:00 UTC Tue"},
+			ends: true,
+		},
+		{
+			name: "count ignores days before start", rule: "FREQ=MONTHLY;BYMONTHDAY=1,15;COUNT=2", start: start,
+			want: []string{"2023-01-15 09:00 UTC Sun", "2023-02-01 09:00 UTC Wed"},
+			ends: true,
+		},
+		{
+			name: "never matches", rule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", start: start,
+			ends: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := len(tt.want)
+			if tt.ends {
+				n++
+			}
+			assert.Equal(t, tt.want, occurrences(t, tt.rule, tt.start, n))
+		})
+	}
+}
+
+func TestIteratorKeepsWallClockAcrossDST(t *testing.T) {
+	berlin := mustLoad(t, "Europe/Berlin")
+	// clocks go forward on 26 March and back on 29 October 2023
+	got := occurrences(t, "FREQ=WEEKLY", time.Date(2023, 3, 19, 9, 0, 0, 0, berlin), 2)
+	assert.Equal(t, []string{"2023-03-19 09:00 CET Sun", "2023-03-26 09:00 CEST Sun"}, got)
+	got = occurrences(t, "FREQ=DAILY", time.Date(2023, 10, 28, 9, 0, 0, 0, berlin), 2)
+	assert.Equal(t, []string{"2023-10-28 09:00 CEST Sat", "2023-10-29 09:00 CET Sun"}, got)
+
+	r, err := Parse("FREQ=DAILY")
+	require.NoError(t, err)
+	next, ok := r.After(time.Date(2023, 3, 25, 9, 0, 0, 0, berlin), time.Date(2023, 3, 25, 9, 0, 0, 0, berlin))
+	require.True(t, ok)
+	assert.Equal(t, 23*time.Hour, next.Sub(time.Date(2023, 3, 25, 9, 0, 0, 0, berlin)), "the day clocks go forward is an hour short")
+}
+
+func TestIteratorSkippedAndRepeatedTimes(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	// 02:30 does not exist on 12 March 2023 and is read with the offset
+	// before the gap, i.e. as 03:30 EDT
+	got := occurrences(t, "FREQ=DAILY", time.Date(2023, 3, 11, 2, 30, 0, 0, ny), 3)
+	assert.Equal(t, []string{"2023-03-11 02:30 EST Sat", "2023-03-12 03:30 EDT Sun", "2023-03-13 02:30 EDT Mon"}, got)
+
+	// 01:30 happens twice on 5 November 2023; the first one is meant
+	r, err := Parse("FREQ=DAILY")
+	require.NoError(t, err)
+	start := time.Date(2023, 11, 4, 1, 30, 0, 0, ny)
+	next, ok := r.After(start, start)
+	require.True(t, ok)
+	assert.Equal(t, "2023-11-05 01:30 EDT", next.Format("2006-01-02 15:04 MST"))
+	next, ok = r.After(start, next)
+	require.True(t, ok)
+	assert.Equal(t, "2023-11-06 01:30 EST", next.Format("2006-01-02 15:04 MST"))
+}
+
+func TestUntilLocalUsesStartTimeZone(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	got := occurrences(t, "FREQ=DAILY;UNTIL=20230103T090000", time.Date(2023, 1, 2, 9, 0, 0, 0, tokyo), 5)
+	assert.Equal(t, []string{"2023-01-02 09:00 JST Mon", "2023-01-03 09:00 JST Tue"}, got)
+}
+
+func TestFollowing(t *testing.T) {
+	r, err := Parse("FREQ=WEEKLY;BYDAY=MO;COUNT=3")
+	require.NoError(t, err)
+	start := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+	got := r.Following(start, start, 5)
+	require.Len(t, got, 2, "the count is exhausted")
+	assert.Equal(t, time.Date(2023, 1, 9, 9, 0, 0, 0, time.UTC), got[0])
+
+	_, ok := r.After(start, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
@@ -0,0 +1,302 @@
+// Package rrule parses and evaluates the recurrence rules of RFC 5545, the
+// RRULE property of iCalendar. The DAILY, WEEKLY, MONTHLY and YEARLY
+// frequencies are supported together with the INTERVAL, COUNT, UNTIL,
+// BYMONTH, BYMONTHDAY, BYDAY and WKST rule parts.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = map[Frequency]string{
+	Daily:   "DAILY",
+	Weekly:  "WEEKLY",
+	Monthly: "MONTHLY",
+	Yearly:  "YEARLY",
+}
+
+func (f Frequency) String() string {
+	return frequencyNames[f]
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum is a BYDAY entry: every Weekday of the period, or with N set
+// only the Nth one, counted from the end of the month or year when negative.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayNames[w.Weekday]
+}
+
+type untilForm int
+
+const (
+	untilNone untilForm = iota
+	// untilUTC is an absolute time, e.g. 20231231T230000Z.
+	untilUTC
+	// untilLocal is a wall clock time in the time zone of the start.
+	untilLocal
+	// untilDate includes every occurrence on that day in the time zone of
+	// the start.
+	untilDate
+)
+
+// Rule is a parsed RRULE. Occurrences are computed by Iterator from a start
+// time, the DTSTART of iCalendar, which also supplies the time of day and
+// the time zone of every occurrence.
+type Rule struct {
+	Freq Frequency
+	// Interval is the number of periods between occurrences, at least 1.
+	Interval int
+	// Count bounds the number of occurrences; 0 when unbounded.
+	Count int
+	// Until is the last possible occurrence when HasUntil reports true. For
+	// date and local forms only its wall clock is used.
+	Until      time.Time
+	until      untilForm
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+	WeekStart  time.Weekday
+}
+
+// HasUntil reports whether the rule ends at Until.
+func (r *Rule) HasUntil() bool {
+	return r.until != untilNone
+}
+
+// Parse reads a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH". A leading
+// "RRULE:" is accepted.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s given twice", ErrInvalidRule, name)
+		}
+		seen[name] = true
+		if err := r.set(name, strings.ToUpper(value)); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Rule) set(name, value string) error {
+	var err error
+	switch name {
+	case "FREQ":
+		for f, n := range frequencyNames {
+			if n == value {
+				r.Freq = f
+			}
+		}
+		if r.Freq == 0 {
+			return fmt.Errorf("%w: unsupported frequency %q", ErrInvalidRule, value)
+		}
+	case "INTERVAL":
+		r.Interval, err = parseInt(name, value, 1, 1000)
+	case "COUNT":
+		r.Count, err = parseInt(name, value, 1, 10000)
+	case "UNTIL":
+		err = r.setUntil(value)
+	case "BYMONTH":
+		err = eachValue(value, func(v string) error {
+			m, err := parseInt(name, v, 1, 12)
+			r.ByMonth = append(r.ByMonth, time.Month(m))
+			return err
+		})
+	case "BYMONTHDAY":
+		err = eachValue(value, func(v string) error {
+			d, err := parseInt(name, v, -31, 31)
+			if d == 0 {
+				return fmt.Errorf("%w: BYMONTHDAY must not be 0", ErrInvalidRule)
+			}
+			r.ByMonthDay = append(r.ByMonthDay, d)
+			return err
+		})
+	case "BYDAY":
+		err = eachValue(value, func(v string) error {
+			w, err := parseWeekdayNum(v)
+			r.ByDay = append(r.ByDay, w)
+			return err
+		})
+	case "WKST":
+		r.WeekStart, err = parseWeekday(value)
+	default:
+		return fmt.Errorf("%w: unsupported rule part %s", ErrInvalidRule, name)
+	}
+	return err
+}
+
+func (r *Rule) setUntil(value string) error {
+	layouts := []struct {
+		layout string
+		form   untilForm
+	}{
+		{"20060102T150405Z", untilUTC},
+		{"20060102T150405", untilLocal},
+		{"20060102", untilDate},
+	}
+	for _, l := range layouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			r.Until, r.until = t, l.form
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: malformed UNTIL %q", ErrInvalidRule, value)
+}
+
+func (r *Rule) validate() error {
+	switch {
+	case r.Freq == 0:
+		return fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	case r.Count > 0 && r.HasUntil():
+		return fmt.Errorf("%w: COUNT and UNTIL must not both be given", ErrInvalidRule)
+	case r.Freq == Weekly && len(r.ByMonthDay) > 0:
+		return fmt.Errorf("%w: BYMONTHDAY cannot be used with WEEKLY", ErrInvalidRule)
+	}
+	if r.Freq == Daily || r.Freq == Weekly {
+		for _, w := range r.ByDay {
+			if w.N != 0 {
+				return fmt.Errorf("%w: numbered BYDAY %s needs MONTHLY or YEARLY", ErrInvalidRule, w)
+			}
+		}
+	}
+	return nil
+}
+
+// String formats the rule in a canonical form, which Parse reads back.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	switch r.until {
+	case untilUTC:
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102T150405Z"))
+	case untilLocal:
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102T150405"))
+	case untilDate:
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]string, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			months[i] = strconv.Itoa(int(m))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, w := range r.ByDay {
+			days[i] = w.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func parseInt(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%w: %s must be a number from %d to %d", ErrInvalidRule, name, min, max)
+	}
+	return n, nil
+}
+
+func eachValue(list string, fn func(string) error) error {
+	for _, v := range strings.Split(list, ",") {
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for i, n := range weekdayNames {
+		if n == s {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRule, s)
+}
+
+// parseWeekdayNum reads a BYDAY entry such as MO, 2TU or -1FR.
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	if len(s) < 2 {
+		return WeekdayNum{}, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRule, s)
+	}
+	w, err := parseWeekday(s[len(s)-2:])
+	if err != nil {
+		return WeekdayNum{}, err
+	}
+	num := WeekdayNum{Weekday: w}
+	if n := s[:len(s)-2]; n != "" {
+		if num.N, err = parseInt("BYDAY", strings.TrimPrefix(n, "+"), -53, 53); err != nil {
+			return WeekdayNum{}, err
+		}
+		if num.N == 0 {
+			return WeekdayNum{}, fmt.Errorf("%w: BYDAY must not number a weekday 0", ErrInvalidRule)
+		}
+	}
+	return num, nil
+}
+
+// sortDates orders civil dates and drops duplicates.
+func sortDates(dates []time.Time) []time.Time {
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	out := dates[:0]
+	for i, d := range dates {
+		if i == 0 || !d.Equal(dates[i-1]) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
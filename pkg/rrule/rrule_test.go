@@ -0,0 +1,53 @@
+package rrule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "weekly", in: "FREQ=WEEKLY;BYDAY=MO,TH", want: "FREQ=WEEKLY;BYDAY=MO,TH"},
+		{name: "prefix and case", in: "RRULE:freq=daily;interval=2", want: "FREQ=DAILY;INTERVAL=2"},
+		{name: "numbered weekday", in: "FREQ=MONTHLY;BYDAY=-1FR,+2MO", want: "FREQ=MONTHLY;BYDAY=-1FR,2MO"},
+		{name: "until utc", in: "FREQ=DAILY;UNTIL=20231231T230000Z", want: "FREQ=DAILY;UNTIL=20231231T230000Z"},
+		{name: "until date", in: "FREQ=DAILY;UNTIL=20231231", want: "FREQ=DAILY;UNTIL=20231231"},
+		{name: "everything", in: "FREQ=YEARLY;COUNT=3;BYMONTH=2;BYMONTHDAY=-1;WKST=SU", want: "FREQ=YEARLY;COUNT=3;BYMONTH=2;BYMONTHDAY=-1;WKST=SU"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20231231",
+		"FREQ=DAILY;UNTIL=2023-12-31",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYDAY=0MO",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=DAILY;;",
+	} {
+		_, err := Parse(in)
+		assert.ErrorIs(t, err, ErrInvalidRule, in)
+	}
+}
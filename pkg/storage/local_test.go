@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, l.Put(ctx, "todos/1/a", strings.NewReader("hello")))
+
+	r, err := l.Open(ctx, "todos/1/a")
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	r.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+
+	require.NoError(t, l.Delete(ctx, "todos/1/a"))
+	_, err = l.Open(ctx, "todos/1/a")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, l.Delete(ctx, "todos/1/a"), "deleting twice is no error")
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestLocalPutFailureStoresNothing(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+	require.Error(t, l.Put(ctx, "a", io.MultiReader(strings.NewReader("part"), failingReader{})))
+	_, err = l.Open(ctx, "a")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocalRejectsEscapingKeys(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+	for _, key := range []string{"../secret", "/etc/passwd", "a/../../b", ".", ""} {
+		assert.ErrorIs(t, l.Put(ctx, key, strings.NewReader("x")), ErrInvalidKey, key)
+	}
+}
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	s := NewSigner([]byte("secret"), time.Minute)
+	expires, sig := s.Sign("default/1", now)
+	assert.Equal(t, now.Add(time.Minute).Unix(), expires)
+	assert.NoError(t, s.Verify("default/1", expires, sig, now))
+
+	assert.ErrorIs(t, s.Verify("default/2", expires, sig, now), ErrInvalidSignature)
+	assert.ErrorIs(t, s.Verify("acme/1", expires, sig, now), ErrInvalidSignature)
+	assert.ErrorIs(t, s.Verify("default/1", expires+3600, sig, now), ErrInvalidSignature, "the expiry is signed")
+	assert.ErrorIs(t, s.Verify("default/1", expires, sig, now.Add(time.Minute)), ErrExpired)
+	assert.ErrorIs(t, NewSigner([]byte("other"), time.Minute).Verify("default/1", expires, sig, now), ErrInvalidSignature)
+}
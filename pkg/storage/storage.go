@@ -0,0 +1,25 @@
+// Package storage keeps the file contents behind attachments, addressed by
+// slash separated keys.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Storage is a blob store. Implementations must be safe for concurrent use.
+type Storage interface {
+	// Put stores the contents of r under key, replacing any previous blob.
+	// Nothing is stored when r fails.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open returns the blob stored under key, or ErrNotFound.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under key; a missing blob is no error.
+	Delete(ctx context.Context, key string) error
+}
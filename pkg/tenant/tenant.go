@@ -0,0 +1,102 @@
+package tenant
+
+import (
+	"context"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/auth"
+	"net/http"
+	"regexp"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// Header selects the tenant of requests whose token carries no tenant claim.
+const Header = "X-Tenant-ID"
+
+// Default is the tenant of requests that name none, and of rows written
+// before tenants existed.
+const Default = "default"
+
+var (
+	ErrInvalidTenant = apperr.New(apperr.CodeValidation, "invalid tenant id")
+	// ErrTenantMismatch is returned for requests asking for a tenant other
+	// than the one their token was issued for.
+	ErrTenantMismatch = apperr.New(apperr.CodeForbidden, "token was issued for another tenant")
+)
+
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+
+type ctxKey struct{}
+
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the tenant of the request. ok is false for background
+// jobs, which act across tenants.
+func FromContext(ctx context.Context) (id string, ok bool) {
+	id, ok = ctx.Value(ctxKey{}).(string)
+	return id, ok
+}
+
+// Resolve picks the tenant of a request: the tenant claim of its principal,
+// else requested, else Default. Requesting another tenant than the claim
+// fails.
+func Resolve(ctx context.Context, requested string) (string, error) {
+	if requested != "" && !validID.MatchString(requested) {
+		return "", ErrInvalidTenant
+	}
+	if p := auth.FromContext(ctx); p != nil && p.Tenant != "" {
+		if requested != "" && requested != p.Tenant {
+			return "", ErrTenantMismatch
+		}
+		return p.Tenant, nil
+	}
+	if requested != "" {
+		return requested, nil
+	}
+	return Default, nil
+}
+
+// Middleware stores the tenant picked by Resolve from the X-Tenant-ID header
+// on the request context. It must run after auth.Middleware.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := Resolve(r.Context(), r.Header.Get(Header))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithID(r.Context(), id)))
+	})
+}
+
+// WebsocketInit wraps an init func that may authenticate the connection and
+// re-resolves the tenant afterwards, honouring an X-Tenant-ID entry of the
+// payload. Without either the tenant set by Middleware on the upgrade
+// request is kept.
+func WebsocketInit(next transport.WebsocketInitFunc) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+		ctx, err := next(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+		requested := payload.GetString(Header)
+		if p := auth.FromContext(ctx); requested == "" && (p == nil || p.Tenant == "") {
+			return ctx, nil
+		}
+		id, err := Resolve(ctx, requested)
+		if err != nil {
+			return nil, err
+		}
+		return WithID(ctx, id), nil
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	if apperr.CodeOf(err) == apperr.CodeForbidden {
+		status = http.StatusForbidden
+	}
+	apperr.WriteHTTP(w, status, err)
+}
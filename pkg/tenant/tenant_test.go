@@ -0,0 +1,102 @@
+package tenant
+
+import (
+	"context"
+	"go-graph/pkg/auth"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	bound := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "7", Tenant: "acme"})
+	unbound := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "7"})
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		requested string
+		want      string
+		err       error
+	}{
+		{name: "anonymous default", ctx: context.Background(), want: Default},
+		{name: "anonymous header", ctx: context.Background(), requested: "globex", want: "globex"},
+		{name: "claim", ctx: bound, want: "acme"},
+		{name: "claim and same header", ctx: bound, requested: "acme", want: "acme"},
+		{name: "claim and other header", ctx: bound, requested: "globex", err: ErrTenantMismatch},
+		{name: "unbound token header", ctx: unbound, requested: "globex", want: "globex"},
+		{name: "invalid header", ctx: context.Background(), requested: "Acme Corp", err: ErrInvalidTenant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve(tt.ctx, tt.requested)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var (
+		seen string
+		ok   bool
+	)
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen, ok = FromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/query", nil)
+	r.Header.Set(Header, "globex")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.True(t, ok)
+	assert.Equal(t, "globex", seen)
+
+	seen, ok = "", false
+	r = r.WithContext(auth.WithPrincipal(r.Context(), &auth.Principal{Subject: "7", Tenant: "acme"}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"FORBIDDEN"`)
+	assert.False(t, ok)
+
+	r.Header.Set(Header, "bad id")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWebsocketInit(t *testing.T) {
+	authenticate := func(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+		if payload.GetString("Authorization") != "" {
+			ctx = auth.WithPrincipal(ctx, &auth.Principal{Subject: "7", Tenant: "acme"})
+		}
+		return ctx, nil
+	}
+	init := WebsocketInit(authenticate)
+	upgraded := WithID(context.Background(), Default)
+
+	ctx, err := init(upgraded, transport.InitPayload{})
+	require.NoError(t, err)
+	id, _ := FromContext(ctx)
+	assert.Equal(t, Default, id)
+
+	ctx, err = init(upgraded, transport.InitPayload{"Authorization": "Bearer x"})
+	require.NoError(t, err)
+	id, _ = FromContext(ctx)
+	assert.Equal(t, "acme", id)
+
+	ctx, err = init(upgraded, transport.InitPayload{Header: "globex"})
+	require.NoError(t, err)
+	id, _ = FromContext(ctx)
+	assert.Equal(t, "globex", id)
+
+	_, err = init(upgraded, transport.InitPayload{"Authorization": "Bearer x", Header: "globex"})
+	assert.ErrorIs(t, err, ErrTenantMismatch)
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/storage"
+	"go-graph/pkg/tenant"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DownloadPath is where the download handler is mounted; attachment links
+// point to DownloadPath followed by the attachment id.
+const DownloadPath = "/files/"
+
+const maxFilenameLength = 255
+
+type ServiceAttachment struct {
+	repo  model.AttachmentRepo
+	store storage.Storage
+	// signer signs the download links handed out with attachments.
+	signer *storage.Signer
+	// todos checks that the caller may access the todo attached to.
+	todos *ServiceTodo
+	// maxSize bounds the size of a file; 0 leaves it to the transport.
+	maxSize int64
+}
+
+func NewServiceAttachment(repo model.AttachmentRepo, store storage.Storage, signer *storage.Signer, todos *ServiceTodo, maxSize int64) *ServiceAttachment {
+	return &ServiceAttachment{
+		repo:    repo,
+		store:   store,
+		signer:  signer,
+		todos:   todos,
+		maxSize: maxSize,
+	}
+}
+
+// AddAttachment stores content as a file of the todo todoID. The content
+// type is sniffed and the checksum computed while the file is written.
+func (s *ServiceAttachment) AddAttachment(ctx context.Context, todoID int, filename string, content io.Reader) (*modelgen.Attachment, error) {
+	filename, err := validateFilename(filename)
+	if err != nil {
+		return nil, err
+	}
+	todo, err := s.todos.findTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := attachmentKey(todo.ID)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(content)
+	head, err := br.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, fmt.Errorf("%w: file must not be empty", ErrInvalidInput)
+	}
+	body := &checksumReader{r: br, hash: sha256.New()}
+	if s.maxSize > 0 {
+		body.r = io.LimitReader(br, s.maxSize+1)
+	}
+	if err := s.store.Put(ctx, key, body); err != nil {
+		return nil, err
+	}
+	if s.maxSize > 0 && body.size > s.maxSize {
+		s.store.Delete(ctx, key)
+		return nil, fmt.Errorf("%w: file must be at most %d bytes", ErrAttachmentTooLarge, s.maxSize)
+	}
+	res, err := s.repo.Create(ctx, &model.Attachment{
+		TodoID:      todo.ID,
+		Filename:    filename,
+		ContentType: http.DetectContentType(head),
+		Size:        body.size,
+		Checksum:    hex.EncodeToString(body.hash.Sum(nil)),
+		StorageKey:  key,
+	})
+	if err != nil {
+		s.store.Delete(ctx, key)
+		return nil, err
+	}
+	return s.toAttachment(res), nil
+}
+
+// GetAttachmentsByTodoIds lists the files of several todos in one query.
+// The result is aligned with ids. The caller is expected to have loaded the
+// todos already, which checked their access.
+func (s *ServiceAttachment) GetAttachmentsByTodoIds(ctx context.Context, ids []int) ([][]*modelgen.Attachment, error) {
+	attachments := make([][]*modelgen.Attachment, len(ids))
+	if len(ids) == 0 {
+		return attachments, nil
+	}
+	res, err := s.repo.FindByTodoIds(ctx, toUintIds(ids))
+	if err != nil {
+		return nil, err
+	}
+	byTodo := make(map[int][]*modelgen.Attachment, len(ids))
+	for _, v := range res {
+		byTodo[int(v.TodoID)] = append(byTodo[int(v.TodoID)], s.toAttachment(v))
+	}
+	for i, id := range ids {
+		attachments[i] = byTodo[id]
+		if attachments[i] == nil {
+			attachments[i] = []*modelgen.Attachment{}
+		}
+	}
+	return attachments, nil
+}
+
+// Download opens the file of attachment id for a link handed out by
+// downloadURL. The link is the authorization: ctx carries the tenant named
+// by the link rather than a principal.
+func (s *ServiceAttachment) Download(ctx context.Context, id int, expires int64, signature string) (*model.Attachment, io.ReadCloser, error) {
+	t, _ := tenant.FromContext(ctx)
+	err := s.signer.Verify(downloadResource(t, id), expires, signature, time.Now())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidDownloadLink, err)
+	}
+	res, err := s.repo.FindById(ctx, uint(id))
+	if err != nil {
+		return nil, nil, notFound(err, ErrAttachmentNotFound)
+	}
+	r, err := s.store.Open(ctx, res.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, r, nil
+}
+
+// downloadURL returns a link to the file of m, relative to the server.
+func (s *ServiceAttachment) downloadURL(m *model.Attachment) string {
+	t := m.TenantID
+	if t == "" {
+		t = tenant.Default
+	}
+	expires, signature := s.signer.Sign(downloadResource(t, int(m.ID)), time.Now())
+	q := url.Values{}
+	q.Set("tenant", t)
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", signature)
+	return DownloadPath + strconv.Itoa(int(m.ID)) + "?" + q.Encode()
+}
+
+func (s *ServiceAttachment) toAttachment(m *model.Attachment) *modelgen.Attachment {
+	return &modelgen.Attachment{
+		ID:          int(m.ID),
+		TodoID:      int(m.TodoID),
+		Filename:    m.Filename,
+		ContentType: m.ContentType,
+		Size:        int(m.Size),
+		Checksum:    m.Checksum,
+		CreatedAt:   m.CreatedAt,
+		URL:         s.downloadURL(m),
+	}
+}
+
+// downloadResource names attachment id of tenantID in signatures, so that
+// a link cannot be replayed against another tenant.
+func downloadResource(tenantID string, id int) string {
+	return tenantID + "/" + strconv.Itoa(id)
+}
+
+// attachmentKey picks a fresh storage key below the todo.
+func attachmentKey(todoID uint) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("todos/%d/%s", todoID, hex.EncodeToString(b)), nil
+}
+
+// validateFilename keeps the base name of filename; clients may send a
+// full path.
+func validateFilename(filename string) (string, error) {
+	filename = strings.TrimSpace(path.Base(strings.ReplaceAll(filename, `\`, "/")))
+	if filename == "" || filename == "." || filename == "/" {
+		return "", fmt.Errorf("%w: file name must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(filename) > maxFilenameLength {
+		return "", fmt.Errorf("%w: file name must be at most %d characters", ErrInvalidInput, maxFilenameLength)
+	}
+	return filename, nil
+}
+
+// checksumReader hashes and counts what is read through it.
+type checksumReader struct {
+	r    io.Reader
+	hash hash.Hash
+	size int64
+}
+
+func (c *checksumReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.hash.Write(p[:n])
+	c.size += int64(n)
+	return n, err
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"go-graph/db/model"
+	"go-graph/pkg/auth"
+	"go-graph/pkg/storage"
+	"go-graph/pkg/tenant"
+	testutil "go-graph/test"
+	"io"
+	"io/fs"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// newAttachmentService stores files below dir on behalf of the todos of
+// ownedTodos.
+func newAttachmentService(t *testing.T, dir string, maxSize int64) (*ServiceAttachment, *testutil.MockAttachmentRepo, *storage.Local) {
+	todos, _ := newSubtaskService(ownedTodos())
+	store, err := storage.NewLocal(dir)
+	require.NoError(t, err)
+	repo := &testutil.MockAttachmentRepo{MockRepo: &testutil.MockRepo[model.Attachment]{}}
+	signer := storage.NewSigner([]byte("secret"), time.Minute)
+	return NewServiceAttachment(repo, store, signer, todos, maxSize), repo, store
+}
+
+func TestAddAttachment(t *testing.T) {
+	s, repo, store := newAttachmentService(t, t.TempDir(), 1024)
+	res, err := s.AddAttachment(client(alice), 1, `C:\shots\screen.png`, bytes.NewReader(png))
+	require.NoError(t, err)
+	assert.Equal(t, "screen.png", res.Filename)
+	assert.Equal(t, "image/png", res.ContentType)
+	assert.Equal(t, len(png), res.Size)
+	sum := sha256.Sum256(png)
+	assert.Equal(t, hex.EncodeToString(sum[:]), res.Checksum)
+	assert.True(t, strings.HasPrefix(res.URL, DownloadPath+"1?"), res.URL)
+
+	r, err := store.Open(context.Background(), repo.Created[0].StorageKey)
+	require.NoError(t, err)
+	b, _ := io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, png, b)
+
+	// bob may not attach files to alice's todo
+	_, err = s.AddAttachment(client(bob), 1, "screen.png", bytes.NewReader(png))
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = s.AddAttachment(client(alice), 1, "empty.png", strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestAddAttachmentTooLarge(t *testing.T) {
+	dir := t.TempDir()
+	s, repo, _ := newAttachmentService(t, dir, int64(len(png)-1))
+	_, err := s.AddAttachment(client(alice), 1, "screen.png", bytes.NewReader(png))
+	assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+	assert.Empty(t, repo.Created)
+	// nothing is left behind in the storage
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			t.Errorf("unexpected file %s", path)
+		}
+		return err
+	})
+	require.NoError(t, err)
+
+	s, _, _ = newAttachmentService(t, dir, int64(len(png)))
+	_, err = s.AddAttachment(client(alice), 1, "screen.png", bytes.NewReader(png))
+	assert.NoError(t, err)
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	s, repo, _ := newAttachmentService(t, t.TempDir(), 0)
+	res, err := s.AddAttachment(client(alice), 1, "screen.png", bytes.NewReader(png))
+	require.NoError(t, err)
+	repo.Model = repo.Created[0]
+	link, err := url.Parse(res.URL)
+	require.NoError(t, err)
+	q := link.Query()
+	expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	require.NoError(t, err)
+	ctx := tenant.WithID(context.Background(), q.Get("tenant"))
+
+	attachment, body, err := s.Download(ctx, 1, expires, q.Get("signature"))
+	require.NoError(t, err)
+	b, _ := io.ReadAll(body)
+	body.Close()
+	assert.Equal(t, png, b)
+	assert.Equal(t, "screen.png", attachment.Filename)
+
+	_, _, err = s.Download(ctx, 2, expires, q.Get("signature"))
+	assert.ErrorIs(t, err, ErrInvalidDownloadLink)
+	_, _, err = s.Download(tenant.WithID(context.Background(), "acme"), 1, expires, q.Get("signature"))
+	assert.ErrorIs(t, err, ErrInvalidDownloadLink, "links cannot be replayed against another tenant")
+	_, _, err = s.Download(ctx, 1, expires+60, q.Get("signature"))
+	assert.ErrorIs(t, err, ErrInvalidDownloadLink)
+}
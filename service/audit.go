@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"strings"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 500
+	// todoAuditEntity is the table todo events are recorded under.
+	todoAuditEntity = "todos"
+)
+
+type ServiceAudit struct {
+	repo model.AuditRepo
+}
+
+func NewServiceAudit(repo model.AuditRepo) *ServiceAudit {
+	return &ServiceAudit{
+		repo: repo,
+	}
+}
+
+// GetAuditEvents lists the latest first events matching filter.
+func (s *ServiceAudit) GetAuditEvents(ctx context.Context, filter *modelgen.AuditEventFilter, first *int) ([]*modelgen.AuditEvent, error) {
+	limit := defaultAuditLimit
+	if first != nil {
+		if *first < 0 || *first > maxAuditLimit {
+			return nil, fmt.Errorf("%w: first must be between 0 and %d", ErrInvalidInput, maxAuditLimit)
+		}
+		limit = *first
+	}
+	if limit == 0 {
+		return []*modelgen.AuditEvent{}, nil
+	}
+	res, err := s.repo.FindFiltered(ctx, toAuditFilter(filter), limit)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*modelgen.AuditEvent, len(res))
+	for i, v := range res {
+		if events[i], err = toAuditEvent(v); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// GetTodoHistoryByIds loads the audit events of several todos in one query.
+// The result is aligned with todoIDs.
+func (s *ServiceAudit) GetTodoHistoryByIds(ctx context.Context, todoIDs []int) ([][]*modelgen.AuditEvent, error) {
+	history := make([][]*modelgen.AuditEvent, len(todoIDs))
+	if len(todoIDs) == 0 {
+		return history, nil
+	}
+	res, err := s.repo.FindByEntityIds(ctx, todoAuditEntity, toUintIds(todoIDs))
+	if err != nil {
+		return nil, err
+	}
+	byTodo := make(map[int][]*modelgen.AuditEvent, len(todoIDs))
+	for _, v := range res {
+		event, err := toAuditEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		byTodo[event.EntityID] = append(byTodo[event.EntityID], event)
+	}
+	for i, id := range todoIDs {
+		history[i] = byTodo[id]
+		if history[i] == nil {
+			history[i] = []*modelgen.AuditEvent{}
+		}
+	}
+	return history, nil
+}
+
+func toAuditFilter(f *modelgen.AuditEventFilter) model.AuditFilter {
+	var filter model.AuditFilter
+	if f == nil {
+		return filter
+	}
+	if f.Entity != nil {
+		filter.Entity = *f.Entity
+	}
+	if f.EntityID != nil {
+		id := uint(*f.EntityID)
+		filter.EntityID = &id
+	}
+	if f.Actor != nil {
+		filter.Actor = *f.Actor
+	}
+	if f.Op != nil {
+		filter.Op = model.AuditOp(strings.ToLower(f.Op.String()))
+	}
+	filter.After = f.After
+	filter.Before = f.Before
+	return filter
+}
+
+func toAuditEvent(m *model.AuditEvent) (*modelgen.AuditEvent, error) {
+	event := &modelgen.AuditEvent{
+		ID:        int(m.ID),
+		Entity:    m.Entity,
+		EntityID:  int(m.EntityID),
+		Op:        modelgen.AuditOp(strings.ToUpper(string(m.Op))),
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Actor != "" {
+		event.Actor = &m.Actor
+	}
+	if m.RequestID != "" {
+		event.RequestID = &m.RequestID
+	}
+	if len(m.Before) > 0 {
+		if err := json.Unmarshal(m.Before, &event.Before); err != nil {
+			return nil, err
+		}
+	}
+	if len(m.After) > 0 {
+		if err := json.Unmarshal(m.After, &event.After); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
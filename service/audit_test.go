@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTodoHistoryByIds(t *testing.T) {
+	repo := &testutil.MockAuditRepo{Events: []*model.AuditEvent{
+		{ID: 1, Entity: "todos", EntityID: 2, Op: model.AuditCreate, Actor: "alice",
+			After: json.RawMessage(`{"title":"draft"}`)},
+		{ID: 2, Entity: "todos", EntityID: 2, Op: model.AuditUpdate,
+			Before: json.RawMessage(`{"title":"draft"}`), After: json.RawMessage(`{"title":"final"}`)},
+	}}
+	s := NewServiceAudit(repo)
+	res, err := s.GetTodoHistoryByIds(context.Background(), []int{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	assert.Empty(t, res[0])
+	require.Equal(t, 2, len(res[1]))
+
+	created := res[1][0]
+	assert.Equal(t, modelgen.AuditOpCreate, created.Op)
+	assert.Equal(t, "alice", *created.Actor)
+	assert.Nil(t, created.Before)
+	assert.Equal(t, "draft", created.After["title"])
+
+	updated := res[1][1]
+	assert.Equal(t, modelgen.AuditOpUpdate, updated.Op)
+	assert.Nil(t, updated.Actor)
+	assert.Equal(t, "final", updated.After["title"])
+}
+
+func TestGetAuditEventsFilter(t *testing.T) {
+	var (
+		repo     = &testutil.MockAuditRepo{}
+		entityID = 3
+		op       = modelgen.AuditOpDelete
+		first    = maxAuditLimit + 1
+	)
+	s := NewServiceAudit(repo)
+	_, err := s.GetAuditEvents(context.Background(), &modelgen.AuditEventFilter{EntityID: &entityID, Op: &op}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), *repo.Filter.EntityID)
+	assert.Equal(t, model.AuditDelete, repo.Filter.Op)
+
+	_, err = s.GetAuditEvents(context.Background(), nil, &first)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/auth"
+	"strings"
+)
+
+const (
+	// maxBatchItems caps the inputs of one batch mutation.
+	maxBatchItems = 500
+	// createBatchSize is how many rows go into one INSERT.
+	createBatchSize = 100
+)
+
+// ItemError is the failure of one input of a batch mutation.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError lists every failed input of a batch mutation. When it is
+// returned nothing has been written.
+type BatchError []*ItemError
+
+func (e BatchError) Error() string {
+	msgs := make([]string, len(e))
+	for i, item := range e {
+		msgs[i] = item.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// CreateTodos creates all inputs in a single transaction. Every input is
+// validated before anything is written; unexpected errors abort the batch
+// instead of being reported per item.
+func (s *ServiceTodo) CreateTodos(ctx context.Context, inputs []*modelgen.NewTodo) ([]*modelgen.Todo, error) {
+	if err := checkBatchSize(len(inputs)); err != nil {
+		return nil, err
+	}
+	var errs BatchError
+	owners := map[string]uint{}
+	todos := make([]*model.Todo, len(inputs))
+	for i, input := range inputs {
+		todo, err := s.buildTodo(ctx, input, owners)
+		if err != nil {
+			if apperr.CodeOf(err) == apperr.CodeInternal {
+				return nil, err
+			}
+			errs = append(errs, &ItemError{Index: i, Err: err})
+			continue
+		}
+		todos[i] = todo
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	err := s.repo.Transaction(ctx, func(tx model.TodoRepo) error {
+		var err error
+		todos, err = tx.CreateInBatches(ctx, todos, createBatchSize)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.publishAll(ctx, modelgen.TodoEventKindCreated, todos), nil
+}
+
+// UpdateTodos applies every patch in a single transaction, together with
+// spawning the next occurrences and rolling up the parents of the todos it
+// completes. All todos are loaded and patched before anything is written.
+func (s *ServiceTodo) UpdateTodos(ctx context.Context, patches []*modelgen.TodoPatch) ([]*modelgen.Todo, error) {
+	if err := checkBatchSize(len(patches)); err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(patches))
+	for i, p := range patches {
+		ids[i] = p.ID
+	}
+	todos, errs, err := s.findBatch(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	completed := make([]bool, len(patches))
+	for i, p := range patches {
+		if todos[i] == nil {
+			continue
+		}
+		if err := checkVersion(todos[i], p.ExpectedVersion); err != nil {
+			errs = append(errs, &ItemError{Index: i, Err: err})
+			continue
+		}
+		done, err := applyUpdate(todos[i], p.Changes)
+		if err != nil {
+			errs = append(errs, &ItemError{Index: i, Err: err})
+			continue
+		}
+		completed[i] = done
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	var followUps []*completion
+	err = s.repo.Transaction(ctx, func(tx model.TodoRepo) error {
+		for i, todo := range todos {
+			if _, err := saveTodo(ctx, tx, todo); err != nil {
+				if apperr.CodeOf(err) == apperr.CodeConflict {
+					return BatchError{{Index: i, Err: err}}
+				}
+				return err
+			}
+		}
+		// todos are completed once all patches are saved, so parents see
+		// the children completed by the same batch
+		for i, todo := range todos {
+			if !completed[i] {
+				continue
+			}
+			c, err := s.complete(ctx, tx, todo)
+			if err != nil {
+				return BatchError{{Index: i, Err: err}}
+			}
+			followUps = append(followUps, c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated := s.publishAll(ctx, modelgen.TodoEventKindUpdated, todos)
+	for _, c := range followUps {
+		s.publishCompletion(ctx, c)
+	}
+	return updated, nil
+}
+
+// DeleteTodos soft-deletes every todo in a single transaction and returns
+// them as they were before deletion.
+func (s *ServiceTodo) DeleteTodos(ctx context.Context, ids []int) ([]*modelgen.Todo, error) {
+	if err := checkBatchSize(len(ids)); err != nil {
+		return nil, err
+	}
+	todos, errs, err := s.findBatch(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	err = s.repo.Transaction(ctx, func(tx model.TodoRepo) error {
+		for _, todo := range todos {
+			if err := tx.Delete(ctx, todo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.publishAll(ctx, modelgen.TodoEventKindDeleted, todos), nil
+}
+
+// findBatch loads the todos of a batch in one query. The result is aligned
+// with ids; missing, inaccessible and repeated ids are reported as item
+// errors, the first two alike for callers restricted by todoScope.
+func (s *ServiceTodo) findBatch(ctx context.Context, ids []int) ([]*model.Todo, BatchError, error) {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	missing := ErrTodoNotFound
+	if scope != nil {
+		missing = auth.ErrForbidden
+	}
+	keys := make([]any, len(ids))
+	for i, id := range ids {
+		keys[i] = uint(id)
+	}
+	res, err := s.repo.FindAllByIds(ctx, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[int]*model.Todo, len(res))
+	for _, v := range res {
+		byID[int(v.ID)] = v
+	}
+	var errs BatchError
+	todos := make([]*model.Todo, len(ids))
+	seen := make(map[int]int, len(ids))
+	for i, id := range ids {
+		if first, ok := seen[id]; ok {
+			errs = append(errs, &ItemError{Index: i, Err: fmt.Errorf("%w: todo %d is already item %d", ErrInvalidInput, id, first)})
+			continue
+		}
+		seen[id] = i
+		todo, ok := byID[id]
+		if !ok {
+			errs = append(errs, &ItemError{Index: i, Err: missing})
+			continue
+		}
+		if !inScope(scope, todo) {
+			errs = append(errs, &ItemError{Index: i, Err: auth.ErrForbidden})
+			continue
+		}
+		todos[i] = todo
+	}
+	return todos, errs, nil
+}
+
+// publishAll publishes one event per todo and returns them converted.
+func (s *ServiceTodo) publishAll(ctx context.Context, kind modelgen.TodoEventKind, todos []*model.Todo) []*modelgen.Todo {
+	res := make([]*modelgen.Todo, len(todos))
+	for i, todo := range todos {
+		res[i] = s.publish(ctx, kind, todo)
+	}
+	return res
+}
+
+func checkBatchSize(n int) error {
+	if n == 0 || n > maxBatchItems {
+		return fmt.Errorf("%w: a batch must hold between 1 and %d items", ErrInvalidInput, maxBatchItems)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxCommentLength = 5000
+
+type ServiceComment struct {
+	repo model.CommentRepo
+	// todos checks that the caller may access the todo commented on.
+	todos *ServiceTodo
+}
+
+func NewServiceComment(repo model.CommentRepo, todos *ServiceTodo) *ServiceComment {
+	return &ServiceComment{
+		repo:  repo,
+		todos: todos,
+	}
+}
+
+// AddComment comments on a todo as the signed-in user. parentID, when set,
+// must be a live comment of the same todo.
+func (s *ServiceComment) AddComment(ctx context.Context, todoID int, body string, parentID *int) (*modelgen.Comment, error) {
+	author, err := commentAuthor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err = validateCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+	todo, err := s.todos.findTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	comment := &model.Comment{TodoID: todo.ID, AuthorID: author.UserID, Body: body}
+	if parentID != nil {
+		parent, err := s.findComment(ctx, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.TodoID != todo.ID {
+			return nil, fmt.Errorf("%w: parent comment belongs to another todo", ErrInvalidInput)
+		}
+		comment.ParentID = &parent.ID
+	}
+	res, err := s.repo.Create(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+	return toComment(res), nil
+}
+
+// EditComment replaces the body of a comment. Only its author may edit it,
+// admins included.
+func (s *ServiceComment) EditComment(ctx context.Context, id int, body string) (*modelgen.Comment, error) {
+	author, err := commentAuthor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err = validateCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != author.UserID {
+		return nil, fmt.Errorf("%w: only the author may edit a comment", auth.ErrForbidden)
+	}
+	if comment.Body == body {
+		return toComment(comment), nil
+	}
+	now := time.Now()
+	comment.Body, comment.EditedAt = body, &now
+	res, err := s.repo.Update(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+	return toComment(res), nil
+}
+
+// DeleteComment clears the body of a comment and marks it deleted; its
+// replies are kept. The author and admins may delete a comment.
+func (s *ServiceComment) DeleteComment(ctx context.Context, id int) (*modelgen.Comment, error) {
+	author, err := commentAuthor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != author.UserID && !author.HasRole(auth.RoleAdmin) {
+		return nil, fmt.Errorf("%w: only the author may delete a comment", auth.ErrForbidden)
+	}
+	now := time.Now()
+	comment.Body, comment.DeletedAt = "", &now
+	res, err := s.repo.Update(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+	return toComment(res), nil
+}
+
+// GetComments pages through the comments of a todo in the order they were
+// written. Deleted comments are listed without their body. The caller is
+// expected to have loaded the todo already, which checked its access.
+func (s *ServiceComment) GetComments(ctx context.Context, todoID int, first *int, after *string) (*modelgen.CommentConnection, error) {
+	req, err := pageRequest(first, after, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.First == 0 {
+		return toCommentConnection(&model.Page[model.Comment]{}), nil
+	}
+	req.Where = map[string]any{"todo_id": uint(todoID)}
+	page, err := s.repo.Paginate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toCommentConnection(page), nil
+}
+
+func toCommentConnection(page *model.Page[model.Comment]) *modelgen.CommentConnection {
+	conn := &modelgen.CommentConnection{Edges: make([]*modelgen.CommentEdge, len(page.Items))}
+	for i, v := range page.Items {
+		conn.Edges[i] = &modelgen.CommentEdge{Node: toComment(v), Cursor: page.Cursors[i].Encode()}
+	}
+	conn.PageInfo = toPageInfo(page)
+	return conn
+}
+
+// CommentPage is the key of the first page of comments on a todo.
+type CommentPage struct {
+	TodoID int
+	First  int
+}
+
+// FirstCommentPage validates first like GetComments and returns the key of
+// the first page of comments on todoID.
+func FirstCommentPage(todoID int, first *int) (CommentPage, error) {
+	req, err := pageRequest(first, nil, nil, nil)
+	if err != nil {
+		return CommentPage{}, err
+	}
+	return CommentPage{TodoID: todoID, First: req.First}, nil
+}
+
+// GetFirstCommentPages loads the first pages of comments on several todos
+// with one query per page size. The result is aligned with pages; like
+// GetComments it expects the todos to be loaded already.
+func (s *ServiceComment) GetFirstCommentPages(ctx context.Context, pages []CommentPage) ([]*modelgen.CommentConnection, error) {
+	bySize := map[int][]uint{}
+	for _, p := range pages {
+		if p.First > 0 {
+			bySize[p.First] = append(bySize[p.First], uint(p.TodoID))
+		}
+	}
+	found := map[CommentPage][]*model.Comment{}
+	for first, ids := range bySize {
+		// one comment more tells whether there is a next page
+		res, err := s.repo.FindFirstByTodoIds(ctx, ids, first+1)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range res {
+			key := CommentPage{TodoID: int(v.TodoID), First: first}
+			found[key] = append(found[key], v)
+		}
+	}
+	conns := make([]*modelgen.CommentConnection, len(pages))
+	for i, p := range pages {
+		page := &model.Page[model.Comment]{Items: found[p]}
+		if len(page.Items) > p.First {
+			page.Items, page.HasNextPage = page.Items[:p.First], true
+		}
+		page.Cursors = make([]model.Cursor, len(page.Items))
+		for j, v := range page.Items {
+			page.Cursors[j] = model.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+		}
+		conns[i] = toCommentConnection(page)
+	}
+	return conns, nil
+}
+
+// findComment loads a live comment on a todo the caller may access. Like
+// findTodo it denies callers restricted by todoScope alike whether the
+// comment is missing or on someone else's todo.
+func (s *ServiceComment) findComment(ctx context.Context, id int) (*model.Comment, error) {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	missing := ErrCommentNotFound
+	if scope != nil {
+		missing = auth.ErrForbidden
+	}
+	if id <= 0 {
+		return nil, missing
+	}
+	res, err := s.repo.FindById(ctx, uint(id))
+	if err != nil {
+		return nil, notFound(err, missing)
+	}
+	if res.DeletedAt != nil {
+		return nil, missing
+	}
+	if _, err := s.todos.findTodo(ctx, int(res.TodoID)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// commentAuthor returns the signed-in user comments are written as.
+func commentAuthor(ctx context.Context) (*auth.Principal, error) {
+	p, err := auth.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID == 0 {
+		return nil, fmt.Errorf("%w: comments must be written by a user", auth.ErrForbidden)
+	}
+	return p, nil
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", fmt.Errorf("%w: comment must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", fmt.Errorf("%w: comment must be at most %d characters", ErrInvalidInput, maxCommentLength)
+	}
+	return body, nil
+}
+
+func toComment(m *model.Comment) *modelgen.Comment {
+	comment := &modelgen.Comment{
+		ID:        int(m.ID),
+		TodoID:    int(m.TodoID),
+		AuthorID:  int(m.AuthorID),
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		EditedAt:  m.EditedAt,
+		DeletedAt: m.DeletedAt,
+	}
+	if m.ParentID != nil {
+		parentID := int(*m.ParentID)
+		comment.ParentID = &parentID
+	}
+	return comment
+}
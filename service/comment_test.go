@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/pkg/auth"
+	testutil "go-graph/test"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var bob = &auth.Principal{Subject: "2", UserID: 2, Roles: []string{auth.RoleUser}}
+
+// newCommentService serves comment, written by alice on todo 1 of alice,
+// from every lookup of the comment repository.
+func newCommentService(comment *model.Comment) (*ServiceComment, *testutil.MockCommentRepo) {
+	todos, _ := newSubtaskService(ownedTodos())
+	repo := &testutil.MockCommentRepo{MockRepo: &testutil.MockRepo[model.Comment]{Model: comment}}
+	return NewServiceComment(repo, todos), repo
+}
+
+func aliceComment() *model.Comment {
+	return &model.Comment{ID: 5, TodoID: 1, AuthorID: 1, Body: "first"}
+}
+
+func TestAddComment(t *testing.T) {
+	s, _ := newCommentService(aliceComment())
+	res, err := s.AddComment(client(alice), 1, " first ", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5, res.ID)
+
+	_, err = s.AddComment(client(nil), 1, "first", nil)
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+	_, err = s.AddComment(client(alice), 1, "  ", nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	// bob may not comment on alice's todo
+	_, err = s.AddComment(client(bob), 1, "hi", nil)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+func TestAddCommentReply(t *testing.T) {
+	s, repo := newCommentService(aliceComment())
+	parent := 5
+	_, err := s.AddComment(client(admin), 1, "reply", &parent)
+	require.NoError(t, err)
+
+	// the parent must belong to the same todo
+	_, err = s.AddComment(client(admin), 2, "reply", &parent)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	repo.Model.DeletedAt = new(time.Time)
+	_, err = s.AddComment(client(admin), 1, "reply", &parent)
+	assert.ErrorIs(t, err, ErrCommentNotFound)
+}
+
+func TestEditCommentOnlyByAuthor(t *testing.T) {
+	s, _ := newCommentService(aliceComment())
+	_, err := s.EditComment(client(admin), 5, "changed")
+	assert.ErrorIs(t, err, auth.ErrForbidden, "not even admins may edit")
+
+	res, err := s.EditComment(client(alice), 5, "changed")
+	require.NoError(t, err)
+	assert.Equal(t, "changed", res.Body)
+	assert.NotNil(t, res.EditedAt)
+
+	s, _ = newCommentService(aliceComment())
+	res, err = s.EditComment(client(alice), 5, "first")
+	require.NoError(t, err)
+	assert.Nil(t, res.EditedAt, "an unchanged body is not an edit")
+}
+
+func TestDeleteComment(t *testing.T) {
+	s, repo := newCommentService(aliceComment())
+	res, err := s.DeleteComment(client(admin), 5)
+	require.NoError(t, err)
+	assert.Empty(t, res.Body)
+	assert.NotNil(t, res.DeletedAt)
+
+	_, err = s.EditComment(client(alice), 5, "back")
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = s.EditComment(client(admin), 5, "back")
+	assert.ErrorIs(t, err, ErrCommentNotFound)
+
+	repo.Model = aliceComment()
+	repo.Model.AuthorID = 2
+	_, err = s.DeleteComment(client(alice), 5)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	// a missing comment looks the same as one on someone else's todo
+	repo.Err = gorm.ErrRecordNotFound
+	_, err = s.DeleteComment(client(alice), 6)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = s.DeleteComment(client(admin), 6)
+	assert.ErrorIs(t, err, ErrCommentNotFound)
+}
+
+func TestGetComments(t *testing.T) {
+	parent := uint(5)
+	s, repo := newCommentService(nil)
+	repo.Models = []*model.Comment{
+		{ID: 5, TodoID: 1, AuthorID: 1, Body: "first"},
+		{ID: 6, TodoID: 1, AuthorID: 2, Body: "reply", ParentID: &parent},
+	}
+	conn, err := s.GetComments(context.Background(), 1, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, conn.Edges, 2)
+	require.NotNil(t, conn.Edges[1].Node.ParentID)
+	assert.Equal(t, 5, *conn.Edges[1].Node.ParentID)
+
+	zero := 0
+	conn, err = s.GetComments(context.Background(), 1, &zero, nil)
+	require.NoError(t, err)
+	assert.Empty(t, conn.Edges)
+}
+
+func TestGetFirstCommentPages(t *testing.T) {
+	s, repo := newCommentService(nil)
+	repo.Models = []*model.Comment{
+		{ID: 5, TodoID: 1, Body: "first"},
+		{ID: 6, TodoID: 1, Body: "second"},
+		{ID: 7, TodoID: 2, Body: "only"},
+	}
+	pages := []CommentPage{{TodoID: 1, First: 1}, {TodoID: 2, First: 1}, {TodoID: 3, First: 1}, {TodoID: 1, First: 0}}
+	conns, err := s.GetFirstCommentPages(context.Background(), pages)
+	require.NoError(t, err)
+	require.Len(t, conns, 4)
+	require.Len(t, conns[0].Edges, 1)
+	assert.Equal(t, "first", conns[0].Edges[0].Node.Body)
+	assert.True(t, conns[0].PageInfo.HasNextPage)
+	require.Len(t, conns[1].Edges, 1)
+	assert.False(t, conns[1].PageInfo.HasNextPage)
+	assert.Empty(t, conns[2].Edges)
+	assert.Empty(t, conns[3].Edges)
+	// pages of one size are loaded together, empty ones not at all
+	require.Len(t, repo.FirstPageLookups, 1)
+	assert.ElementsMatch(t, []uint{1, 2, 3}, repo.FirstPageLookups[0])
+}
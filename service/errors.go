@@ -0,0 +1,36 @@
+package service
+
+import (
+	"errors"
+	"go-graph/pkg/apperr"
+
+	"gorm.io/gorm"
+)
+
+// Errors returned by the services carry an apperr code. Details are added
+// by wrapping, e.g. fmt.Errorf("%w: text must not be empty", ErrInvalidInput);
+// any error without a code is treated as internal and masked.
+var (
+	ErrTodoNotFound       = apperr.New(apperr.CodeNotFound, "todo not found")
+	ErrUserNotFound       = apperr.New(apperr.CodeNotFound, "user not found")
+	ErrTagNotFound        = apperr.New(apperr.CodeNotFound, "tag not found")
+	ErrTagExists          = apperr.New(apperr.CodeConflict, "tag already exists")
+	ErrProjectNotFound    = apperr.New(apperr.CodeNotFound, "project not found")
+	ErrProjectArchived    = apperr.New(apperr.CodeValidation, "project is archived")
+	ErrCommentNotFound    = apperr.New(apperr.CodeNotFound, "comment not found")
+	ErrAttachmentNotFound = apperr.New(apperr.CodeNotFound, "attachment not found")
+	ErrAttachmentTooLarge = apperr.New(apperr.CodeValidation, "attachment too large")
+	// ErrInvalidDownloadLink is returned for download links that were
+	// tampered with or have expired.
+	ErrInvalidDownloadLink = apperr.New(apperr.CodeForbidden, "invalid download link")
+	ErrInvalidInput        = apperr.New(apperr.CodeValidation, "invalid input")
+)
+
+// notFound maps gorm.ErrRecordNotFound to target and passes every other
+// error through unchanged.
+func notFound(err error, target error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return target
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageRequest validates Relay connection arguments and turns them into a
+// model.PageRequest. Without first and last the first defaultPageSize rows
+// are returned.
+func pageRequest(first *int, after *string, last *int, before *string) (model.PageRequest, error) {
+	var req model.PageRequest
+	if first != nil && last != nil {
+		return req, fmt.Errorf("%w: first and last must not be used together", ErrInvalidInput)
+	}
+	switch {
+	case last != nil:
+		if *last < 0 || *last > maxPageSize {
+			return req, fmt.Errorf("%w: last must be between 0 and %d", ErrInvalidInput, maxPageSize)
+		}
+		req.Last = *last
+	case first != nil:
+		if *first < 0 || *first > maxPageSize {
+			return req, fmt.Errorf("%w: first must be between 0 and %d", ErrInvalidInput, maxPageSize)
+		}
+		req.First = *first
+	default:
+		req.First = defaultPageSize
+	}
+	if after != nil {
+		c, err := model.DecodeCursor(*after)
+		if err != nil {
+			return req, fmt.Errorf("%w: after: %v", ErrInvalidInput, err)
+		}
+		req.After = &c
+	}
+	if before != nil {
+		c, err := model.DecodeCursor(*before)
+		if err != nil {
+			return req, fmt.Errorf("%w: before: %v", ErrInvalidInput, err)
+		}
+		req.Before = &c
+	}
+	return req, nil
+}
+
+func toPageInfo[T any](page *model.Page[T]) *modelgen.PageInfo {
+	info := &modelgen.PageInfo{
+		HasNextPage:     page.HasNextPage,
+		HasPreviousPage: page.HasPreviousPage,
+	}
+	if n := len(page.Cursors); n > 0 {
+		start, end := page.Cursors[0].Encode(), page.Cursors[n-1].Encode()
+		info.StartCursor, info.EndCursor = &start, &end
+	}
+	return info
+}
+
+// encodeOffsetCursor returns an opaque cursor for the row at offset in lists
+// such as ranked search results, where keyset cursors do not apply.
+func encodeOffsetCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeOffsetCursor(s string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, model.ErrInvalidCursor
+	}
+	const prefix = "offset:"
+	if !strings.HasPrefix(string(raw), prefix) {
+		return 0, model.ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), prefix))
+	if err != nil || offset < 0 {
+		return 0, model.ErrInvalidCursor
+	}
+	return offset, nil
+}
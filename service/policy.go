@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/pkg/auth"
+)
+
+// todoScope returns the user whose todos the caller may read and write, or
+// nil when access is unrestricted. Admins and background jobs are
+// unrestricted; other API clients must be signed in and only see their own
+// todos.
+func todoScope(ctx context.Context) (*uint, error) {
+	if !auth.IsClient(ctx) {
+		return nil, nil
+	}
+	p, err := auth.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.HasRole(auth.RoleAdmin) {
+		return nil, nil
+	}
+	if p.UserID == 0 {
+		return nil, auth.ErrForbidden
+	}
+	owner := p.UserID
+	return &owner, nil
+}
+
+// inScope reports whether todo belongs to the owner returned by todoScope.
+func inScope(scope *uint, todo *model.Todo) bool {
+	return scope == nil || (todo.UserID != nil && *todo.UserID == *scope)
+}
+
+// authorizeOwner checks that the caller may act on behalf of userID, e.g.
+// create todos for them.
+func authorizeOwner(ctx context.Context, userID uint) error {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return err
+	}
+	if scope != nil && *scope != userID {
+		return auth.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeProject checks that the caller may change project. Admins and
+// background jobs may change any project, other users only the ones they
+// own.
+func authorizeProject(ctx context.Context, project *model.Project) error {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return err
+	}
+	if scope != nil && (project.OwnerID == nil || *project.OwnerID != *scope) {
+		return auth.ErrForbidden
+	}
+	return nil
+}
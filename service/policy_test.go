@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// client returns the context of an API request made by p; nil is anonymous.
+func client(p *auth.Principal) context.Context {
+	ctx := auth.WithClient(context.Background())
+	if p == nil {
+		return ctx
+	}
+	return auth.WithPrincipal(ctx, p)
+}
+
+var (
+	alice = &auth.Principal{Subject: "1", UserID: 1, Roles: []string{auth.RoleUser}}
+	admin = &auth.Principal{Subject: "9", UserID: 9, Roles: []string{auth.RoleAdmin}}
+)
+
+// ownedTodos returns todo 1 of alice and todo 2 of bob.
+func ownedTodos() []*model.Todo {
+	aliceID, bobID := uint(1), uint(2)
+	return []*model.Todo{
+		{Model: gorm.Model{ID: 1}, Title: "alice's", UserID: &aliceID},
+		{Model: gorm.Model{ID: 2}, Title: "bob's", UserID: &bobID},
+	}
+}
+
+func TestTodoScope(t *testing.T) {
+	scope, err := todoScope(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, scope, "background jobs are unrestricted")
+
+	_, err = todoScope(client(nil))
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+	scope, err = todoScope(client(alice))
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), *scope)
+
+	scope, err = todoScope(client(admin))
+	require.NoError(t, err)
+	assert.Nil(t, scope)
+
+	_, err = todoScope(client(&auth.Principal{Subject: "service-account"}))
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+func TestGetTodoDeniesWithoutRevealingExistence(t *testing.T) {
+	s, _ := newSubtaskService(ownedTodos())
+	res, err := s.GetTodo(client(alice), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice's", res.Text)
+
+	_, foreign := s.GetTodo(client(alice), "2")
+	_, missing := s.GetTodo(client(alice), "3")
+	assert.ErrorIs(t, foreign, auth.ErrForbidden)
+	assert.Equal(t, foreign, missing)
+
+	_, err = s.GetTodo(client(admin), "3")
+	assert.ErrorIs(t, err, ErrTodoNotFound)
+}
+
+func TestUpdateTodoOfOtherUserIsForbidden(t *testing.T) {
+	s, _ := newSubtaskService(ownedTodos())
+	done := true
+	_, err := s.UpdateTodo(client(alice), 2, &modelgen.UpdateTodo{Done: &done}, nil)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = s.DeleteTodo(client(alice), 2)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	res, err := s.UpdateTodo(client(admin), 2, &modelgen.UpdateTodo{Done: &done}, nil)
+	require.NoError(t, err)
+	assert.True(t, res.Done)
+}
+
+func TestGetTodosIsScopedToCaller(t *testing.T) {
+	repo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}
+	s := newServiceTodo(repo, &testutil.MockRepo[model.User]{})
+	_, err := s.GetTodos(client(alice), nil, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, repo.Filter.UserID)
+	assert.Equal(t, uint(1), *repo.Filter.UserID)
+
+	bob := 2
+	_, err = s.GetTodos(client(alice), &bob, nil, nil)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+func TestNewTodoForOtherUserIsForbidden(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{Model: ownedTodos()[1]})
+	_, err := s.NewTodo(client(alice), &modelgen.NewTodo{Text: "task", UserID: "2"})
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+func TestDeleteTodosReportsForeignItems(t *testing.T) {
+	repo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{Models: ownedTodos()}}
+	s := newServiceTodo(repo, &testutil.MockRepo[model.User]{})
+	_, err := s.DeleteTodos(client(alice), []int{1, 2, 3})
+	var batchErr BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, 2, len(batchErr))
+	assert.Equal(t, 1, batchErr[0].Index)
+	assert.ErrorIs(t, batchErr[0], auth.ErrForbidden)
+	assert.Equal(t, 2, batchErr[1].Index)
+	assert.ErrorIs(t, batchErr[1], auth.ErrForbidden)
+}
+
+func TestGetTrashedTodosIsScopedToCaller(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{Models: ownedTodos()})
+	res, err := s.GetTrashedTodos(client(alice))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, 1, res[0].ID)
+
+	_, err = s.RestoreTodo(client(alice), 2)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = s.PurgeTodo(client(alice), 2)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+}
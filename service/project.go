@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxProjectNameLength = 100
+
+var projectColor = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+type ServiceProject struct {
+	repo model.ProjectRepo
+}
+
+func NewServiceProject(repo model.ProjectRepo) *ServiceProject {
+	return &ServiceProject{
+		repo: repo,
+	}
+}
+
+// NewProject creates a project owned by the signed-in caller.
+func (s *ServiceProject) NewProject(ctx context.Context, input *modelgen.NewProject) (*modelgen.Project, error) {
+	if _, err := todoScope(ctx); err != nil {
+		return nil, err
+	}
+	name, err := validateProjectName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	project := &model.Project{Name: name}
+	if p := auth.FromContext(ctx); p != nil && p.UserID != 0 {
+		owner := p.UserID
+		project.OwnerID = &owner
+	}
+	if input.Color != nil {
+		if project.Color, err = normalizeProjectColor(*input.Color); err != nil {
+			return nil, err
+		}
+	}
+	res, err := s.repo.Create(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return toProject(res), nil
+}
+
+func (s *ServiceProject) RenameProject(ctx context.Context, id int, name string) (*modelgen.Project, error) {
+	name, err := validateProjectName(name)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeProject(ctx, project); err != nil {
+		return nil, err
+	}
+	if project.Name == name {
+		return toProject(project), nil
+	}
+	project.Name = name
+	res, err := s.repo.Update(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return toProject(res), nil
+}
+
+// ArchiveProject sets the archived flag of a project. The todos of an
+// archived project stay untouched and are only hidden from listings.
+func (s *ServiceProject) ArchiveProject(ctx context.Context, id int, archived bool) (*modelgen.Project, error) {
+	project, err := s.findProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeProject(ctx, project); err != nil {
+		return nil, err
+	}
+	if project.Archived == archived {
+		return toProject(project), nil
+	}
+	project.Archived = archived
+	res, err := s.repo.Update(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return toProject(res), nil
+}
+
+func (s *ServiceProject) GetProject(ctx context.Context, id int) (*modelgen.Project, error) {
+	project, err := s.findProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProject(project), nil
+}
+
+// GetProjects lists the projects by name; archived ones only when
+// includeArchived is set.
+func (s *ServiceProject) GetProjects(ctx context.Context, includeArchived *bool) ([]*modelgen.Project, error) {
+	res, err := s.repo.FindAll(ctx, includeArchived != nil && *includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]*modelgen.Project, len(res))
+	for i, v := range res {
+		projects[i] = toProject(v)
+	}
+	return projects, nil
+}
+
+// GetProjectsByIds loads projects in a single query. The result is aligned
+// with ids and holds nil where a project does not exist.
+func (s *ServiceProject) GetProjectsByIds(ctx context.Context, ids []int) ([]*modelgen.Project, error) {
+	projects := make([]*modelgen.Project, len(ids))
+	if len(ids) == 0 {
+		return projects, nil
+	}
+	keys := make([]any, len(ids))
+	for i, id := range ids {
+		keys[i] = uint(id)
+	}
+	res, err := s.repo.FindAllByIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*model.Project, len(res))
+	for _, v := range res {
+		byID[int(v.ID)] = v
+	}
+	for i, id := range ids {
+		if v, ok := byID[id]; ok {
+			projects[i] = toProject(v)
+		}
+	}
+	return projects, nil
+}
+
+func (s *ServiceProject) findProject(ctx context.Context, id int) (*model.Project, error) {
+	if id <= 0 {
+		return nil, ErrProjectNotFound
+	}
+	res, err := s.repo.FindById(ctx, uint(id))
+	if err != nil {
+		return nil, notFound(err, ErrProjectNotFound)
+	}
+	return res, nil
+}
+
+func validateProjectName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: project name must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(name) > maxProjectNameLength {
+		return "", fmt.Errorf("%w: project name must be at most %d characters", ErrInvalidInput, maxProjectNameLength)
+	}
+	return name, nil
+}
+
+// normalizeProjectColor lower-cases a #rrggbb color; an empty color clears
+// it.
+func normalizeProjectColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if color != "" && !projectColor.MatchString(color) {
+		return "", fmt.Errorf("%w: color must be a #rrggbb hex color", ErrInvalidInput)
+	}
+	return color, nil
+}
+
+func toProject(m *model.Project) *modelgen.Project {
+	project := &modelgen.Project{
+		ID:        int(m.ID),
+		Name:      m.Name,
+		Archived:  m.Archived,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.OwnerID != nil {
+		ownerID := int(*m.OwnerID)
+		project.OwnerID = &ownerID
+	}
+	if m.Color != "" {
+		color := m.Color
+		project.Color = &color
+	}
+	return project
+}
+
+// GetTodosByProjectIds loads the todos of several projects in one query.
+// The result is aligned with ids and leaves out todos outside the caller's
+// scope.
+func (s *ServiceTodo) GetTodosByProjectIds(ctx context.Context, ids []int) ([][]*modelgen.Todo, error) {
+	todos := make([][]*modelgen.Todo, len(ids))
+	if len(ids) == 0 {
+		return todos, nil
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repo.FindByProjectIds(ctx, toUintIds(ids), scope)
+	if err != nil {
+		return nil, err
+	}
+	byProject := make(map[int][]*modelgen.Todo, len(ids))
+	for _, v := range res {
+		project := int(*v.ProjectID)
+		byProject[project] = append(byProject[project], toTodo(v))
+	}
+	for i, id := range ids {
+		todos[i] = byProject[id]
+		if todos[i] == nil {
+			todos[i] = []*modelgen.Todo{}
+		}
+	}
+	return todos, nil
+}
+
+// MoveTodo moves a todo into projectID, or out of any project when
+// projectID is nil.
+func (s *ServiceTodo) MoveTodo(ctx context.Context, id int, projectID *int, expectedVersion *int) (*modelgen.Todo, error) {
+	todo, err := s.findTodo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
+	todo.ProjectID = nil
+	if projectID != nil {
+		project, err := s.checkProject(ctx, *projectID)
+		if err != nil {
+			return nil, err
+		}
+		todo.ProjectID = &project
+	}
+	res, err := saveTodo(ctx, s.repo, todo)
+	if err != nil {
+		return nil, err
+	}
+	return s.publish(ctx, modelgen.TodoEventKindUpdated, res), nil
+}
+
+// checkProject checks that todos may be put into the project id: it must
+// exist, be changeable by the caller and not be archived.
+func (s *ServiceTodo) checkProject(ctx context.Context, id int) (uint, error) {
+	if id <= 0 {
+		return 0, ErrProjectNotFound
+	}
+	project, err := s.projectRepo.FindById(ctx, uint(id))
+	if err != nil {
+		return 0, notFound(err, ErrProjectNotFound)
+	}
+	if err := authorizeProject(ctx, project); err != nil {
+		return 0, err
+	}
+	if project.Archived {
+		return 0, fmt.Errorf("%w: %q", ErrProjectArchived, project.Name)
+	}
+	return project.ID, nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	"go-graph/pkg/pubsub"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestNewProject(t *testing.T) {
+	mockRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 1}, Name: "Home", Color: "#ff8800"},
+	}}
+	s := NewServiceProject(mockRepo)
+	color := "#FF8800"
+	res, err := s.NewProject(context.Background(), &modelgen.NewProject{Name: " Home ", Color: &color})
+	require.NoError(t, err)
+	assert.Equal(t, "Home", res.Name)
+	require.NotNil(t, res.Color)
+	assert.Equal(t, "#ff8800", *res.Color)
+
+	color = "orange"
+	_, err = s.NewProject(context.Background(), &modelgen.NewProject{Name: "Home", Color: &color})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = s.NewProject(context.Background(), &modelgen.NewProject{Name: " "})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestArchiveProject(t *testing.T) {
+	mockRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 1}, Name: "Home"},
+	}}
+	s := NewServiceProject(mockRepo)
+	res, err := s.ArchiveProject(context.Background(), 1, true)
+	require.NoError(t, err)
+	assert.True(t, res.Archived)
+
+	res, err = s.ArchiveProject(context.Background(), 1, false)
+	require.NoError(t, err)
+	assert.False(t, res.Archived)
+
+	mockRepo.Err = gorm.ErrRecordNotFound
+	_, err = s.ArchiveProject(context.Background(), 2, true)
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+}
+
+func TestGetProjects(t *testing.T) {
+	mockRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Models: []*model.Project{{Model: gorm.Model{ID: 1}, Name: "Home"}},
+	}}
+	s := NewServiceProject(mockRepo)
+	res, err := s.GetProjects(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.False(t, mockRepo.IncludeArchived)
+
+	include := true
+	_, err = s.GetProjects(context.Background(), &include)
+	require.NoError(t, err)
+	assert.True(t, mockRepo.IncludeArchived)
+}
+
+// newServiceTodoWithProjects wires a ServiceTodo over todoRepo and
+// projectRepo.
+func newServiceTodoWithProjects(todoRepo model.TodoRepo, projectRepo model.ProjectRepo) *ServiceTodo {
+	tagRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{}}
+	return NewServiceTodo(todoRepo, &testutil.MockRepo[model.User]{}, tagRepo, projectRepo, pubsub.NewBroker[*modelgen.TodoEvent](1), &testutil.MockStorage{})
+}
+
+func TestMoveTodo(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{Model: gorm.Model{ID: 1}, Title: "task", Version: 1},
+	}}
+	projectRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 3}, Name: "Home"},
+	}}
+	s := newServiceTodoWithProjects(todoRepo, projectRepo)
+	projectID := 3
+	res, err := s.MoveTodo(context.Background(), 1, &projectID, nil)
+	require.NoError(t, err)
+	require.NotNil(t, res.ProjectID)
+	assert.Equal(t, 3, *res.ProjectID)
+
+	res, err = s.MoveTodo(context.Background(), 1, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, res.ProjectID)
+
+	stale := 7
+	_, err = s.MoveTodo(context.Background(), 1, &projectID, &stale)
+	assert.Error(t, err)
+}
+
+func TestMoveTodoIntoArchivedProject(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{Model: gorm.Model{ID: 1}, Title: "task"},
+	}}
+	projectRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 3}, Name: "Old", Archived: true},
+	}}
+	s := newServiceTodoWithProjects(todoRepo, projectRepo)
+	projectID := 3
+	_, err := s.MoveTodo(context.Background(), 1, &projectID, nil)
+	assert.ErrorIs(t, err, ErrProjectArchived)
+
+	projectRepo.Err = gorm.ErrRecordNotFound
+	_, err = s.MoveTodo(context.Background(), 1, &projectID, nil)
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+}
+
+func TestMoveTodoIntoOtherUsersProjectIsForbidden(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{Model: ownedTodos()[0]}}
+	bobID := uint(2)
+	projectRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 3}, Name: "Bob's", OwnerID: &bobID},
+	}}
+	s := newServiceTodoWithProjects(todoRepo, projectRepo)
+	projectID := 3
+	_, err := s.MoveTodo(client(alice), 1, &projectID, nil)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = s.NewTodo(client(alice), &modelgen.NewTodo{Text: "task", UserID: "1", ProjectID: &projectID})
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+func TestGetTodosProjectFilter(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}
+	s := newServiceTodoWithProjects(todoRepo, &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{}})
+	_, err := s.GetTodos(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+	assert.False(t, todoRepo.Filter.IncludeArchived)
+	assert.Nil(t, todoRepo.Filter.ProjectID)
+
+	projectID, include := 3, true
+	_, err = s.GetTodos(context.Background(), nil, &modelgen.TodoFilter{ProjectID: &projectID, IncludeArchived: &include}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, todoRepo.Filter.ProjectID)
+	assert.Equal(t, uint(3), *todoRepo.Filter.ProjectID)
+	assert.True(t, todoRepo.Filter.IncludeArchived)
+}
+
+func TestProjectWritesNeedOwnerOrAdmin(t *testing.T) {
+	aliceID := uint(1)
+	mockRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 1}, Name: "Home", OwnerID: &aliceID},
+	}}
+	s := NewServiceProject(mockRepo)
+	_, err := s.NewProject(client(nil), &modelgen.NewProject{Name: "Work"})
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	_, err = s.ArchiveProject(client(nil), 1, true)
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	_, err = s.ArchiveProject(client(bob), 1, true)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = s.RenameProject(client(bob), 1, "Mine")
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	res, err := s.RenameProject(client(alice), 1, "Chores")
+	require.NoError(t, err)
+	assert.Equal(t, "Chores", res.Name)
+	res, err = s.ArchiveProject(client(admin), 1, true)
+	require.NoError(t, err)
+	assert.True(t, res.Archived)
+}
+
+func TestNewProjectIsOwnedByCaller(t *testing.T) {
+	mockRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{
+		Model: &model.Project{Model: gorm.Model{ID: 1}, Name: "Work"},
+	}}
+	s := NewServiceProject(mockRepo)
+	_, err := s.NewProject(client(alice), &modelgen.NewProject{Name: "Work"})
+	require.NoError(t, err)
+	require.NotEmpty(t, mockRepo.Created)
+	require.NotNil(t, mockRepo.Created[0].OwnerID)
+	assert.Equal(t, uint(1), *mockRepo.Created[0].OwnerID)
+}
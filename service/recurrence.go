@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/rrule"
+	"time"
+)
+
+// recurrenceBatchSize is how many due recurring todos are loaded per query.
+const recurrenceBatchSize = 100
+
+// PreviewRecurrence lists the first count occurrences of the RRULE
+// recurrence repeating from start in timeZone.
+func (s *ServiceTodo) PreviewRecurrence(ctx context.Context, recurrence string, start time.Time, timeZone *string, count int) ([]*time.Time, error) {
+	rule, err := parseRecurrence(recurrence)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := loadTimeZone(timeZone)
+	if err != nil {
+		return nil, err
+	}
+	start = start.In(loc)
+	var res []*time.Time
+	it := rule.Iterator(start)
+	for len(res) < count {
+		next, ok := it.Next()
+		if !ok {
+			break
+		}
+		res = append(res, &next)
+	}
+	return res, nil
+}
+
+// SpawnDueRecurrences spawns the next occurrence of every recurring todo
+// due at now, completed or not, and returns how many it spawned. Todos
+// written by someone else meanwhile are left to the next run.
+func (s *ServiceTodo) SpawnDueRecurrences(ctx context.Context, now time.Time) (int, error) {
+	spawned := 0
+	for {
+		todos, err := s.repo.FindDueRecurrences(ctx, now, recurrenceBatchSize)
+		if err != nil {
+			return spawned, err
+		}
+		skipped := false
+		for _, t := range todos {
+			next, err := s.spawnNext(ctx, s.repo, t, now)
+			if err != nil {
+				return spawned, err
+			}
+			if next != nil {
+				s.publish(ctx, modelgen.TodoEventKindCreated, next)
+				spawned++
+			} else if t.RecurredAt == nil {
+				skipped = true
+			}
+		}
+		// skipped todos would be loaded again right away, so a batch with
+		// any ends the run
+		if len(todos) < recurrenceBatchSize || skipped || ctx.Err() != nil {
+			return spawned, ctx.Err()
+		}
+	}
+}
+
+// spawnNext creates the occurrence of todo's series following it through
+// repo, due at the first occurrence after both its due date and now, and
+// saves todo marked as recurred. It returns nil for one-off and already
+// recurred todos, when the series is over, and when todo was written by
+// someone else meanwhile; todo is left unchanged in that last case. The
+// new todo is left to the caller to publish once its writes are committed.
+func (s *ServiceTodo) spawnNext(ctx context.Context, repo model.TodoRepo, todo *model.Todo, now time.Time) (*model.Todo, error) {
+	if todo.Recurrence == "" || todo.RecurredAt != nil || todo.DueAt == nil {
+		return nil, nil
+	}
+	rule, err := rrule.Parse(todo.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(todo.RecurrenceTZ)
+	if err != nil {
+		return nil, err
+	}
+	start := *todo.DueAt
+	if todo.RecurrenceStart != nil {
+		start = *todo.RecurrenceStart
+	}
+	after := *todo.DueAt
+	if now.After(after) {
+		after = now
+	}
+	due, more := rule.After(start.In(loc), after)
+	tags, err := s.tagRepo.FindByTodoIds(ctx, []uint{todo.ID})
+	if err != nil {
+		return nil, err
+	}
+	var res *model.Todo
+	read := *todo
+	err = repo.Transaction(ctx, func(tx model.TodoRepo) error {
+		todo.RecurredAt = &now
+		if _, err := tx.Update(ctx, todo); err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+		next := nextOccurrence(todo, due)
+		if _, err := tx.Create(ctx, next); err != nil {
+			return err
+		}
+		for _, t := range tags {
+			if err := tx.AddTags(ctx, next, &t.Tag); err != nil {
+				return err
+			}
+		}
+		res = next
+		return nil
+	})
+	if err != nil {
+		*todo = read
+	}
+	if errors.Is(err, model.ErrVersionConflict) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// nextOccurrence copies todo into a new todo due at due. A reminder keeps
+// its distance to the due date; subtasks are not copied.
+func nextOccurrence(todo *model.Todo, due time.Time) *model.Todo {
+	next := &model.Todo{
+		TenantID:        todo.TenantID,
+		Title:           todo.Title,
+		UserID:          todo.UserID,
+		DueAt:           &due,
+		Priority:        todo.Priority,
+		ParentID:        todo.ParentID,
+		AutoComplete:    todo.AutoComplete,
+		ProjectID:       todo.ProjectID,
+		Recurrence:      todo.Recurrence,
+		RecurrenceTZ:    todo.RecurrenceTZ,
+		RecurrenceStart: todo.RecurrenceStart,
+	}
+	if todo.RemindAt != nil {
+		remindAt := due.Add(todo.RemindAt.Sub(*todo.DueAt))
+		next.RemindAt = &remindAt
+	}
+	return next
+}
+
+// setRecurrence makes todo repeat by the RRULE recurrence in timeZone,
+// starting the series at its due date.
+func setRecurrence(todo *model.Todo, recurrence string, timeZone *string) error {
+	rule, err := parseRecurrence(recurrence)
+	if err != nil {
+		return err
+	}
+	loc, err := loadTimeZone(timeZone)
+	if err != nil {
+		return err
+	}
+	if todo.DueAt == nil {
+		return fmt.Errorf("%w: a recurring todo needs a due date", ErrInvalidInput)
+	}
+	start := *todo.DueAt
+	todo.Recurrence, todo.RecurrenceTZ, todo.RecurrenceStart = rule.String(), loc.String(), &start
+	todo.RecurredAt = nil
+	return nil
+}
+
+func clearRecurrence(todo *model.Todo) {
+	todo.Recurrence, todo.RecurrenceTZ = "", ""
+	todo.RecurrenceStart, todo.RecurredAt = nil, nil
+}
+
+func parseRecurrence(recurrence string) (*rrule.Rule, error) {
+	rule, err := rrule.Parse(recurrence)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return rule, nil
+}
+
+// loadTimeZone loads an IANA time zone; nil and empty mean UTC.
+func loadTimeZone(name *string) (*time.Location, error) {
+	if name == nil || *name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(*name)
+	if err != nil || *name == "Local" {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalidInput, *name)
+	}
+	return loc, nil
+}
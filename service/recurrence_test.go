@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	testutil "go-graph/test"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestNewRecurringTodo(t *testing.T) {
+	mockRepo := &testutil.MockRepo[model.Todo]{Model: &model.Todo{Model: gorm.Model{ID: 1}}}
+	s := setupServiceTodo(mockRepo)
+	due := time.Date(2023, 3, 25, 9, 0, 0, 0, time.UTC)
+	rule, tz := "freq=weekly;byday=sa", "Europe/Berlin"
+	_, err := s.NewTodo(context.Background(), &modelgen.NewTodo{
+		Text: "chores", UserID: "1", DueAt: &due, Recurrence: &rule, RecurrenceTimeZone: &tz,
+	})
+	require.NoError(t, err)
+	created := mockRepo.Created[0]
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=SA", created.Recurrence)
+	assert.Equal(t, "Europe/Berlin", created.RecurrenceTZ)
+	assert.Equal(t, due, *created.RecurrenceStart)
+
+	_, err = s.NewTodo(context.Background(), &modelgen.NewTodo{Text: "chores", UserID: "1", Recurrence: &rule})
+	assert.ErrorIs(t, err, ErrInvalidInput, "a due date is required")
+
+	bad := "FREQ=FORTNIGHTLY"
+	_, err = s.NewTodo(context.Background(), &modelgen.NewTodo{Text: "chores", UserID: "1", DueAt: &due, Recurrence: &bad})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	tz = "Mars/Olympus_Mons"
+	_, err = s.NewTodo(context.Background(), &modelgen.NewTodo{Text: "chores", UserID: "1", DueAt: &due, Recurrence: &rule, RecurrenceTimeZone: &tz})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestUpdateTodoRecurrence(t *testing.T) {
+	due := time.Date(2023, 3, 25, 9, 0, 0, 0, time.UTC)
+	mockRepo := &testutil.MockRepo[model.Todo]{Model: &model.Todo{Model: gorm.Model{ID: 1}, DueAt: &due}}
+	s := setupServiceTodo(mockRepo)
+	rule := "FREQ=DAILY"
+	res, err := s.UpdateTodo(context.Background(), 1, &modelgen.UpdateTodo{Recurrence: &rule}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, res.Recurrence)
+	assert.Equal(t, "FREQ=DAILY", *res.Recurrence)
+	assert.Equal(t, "UTC", *res.RecurrenceTimeZone)
+
+	clear := true
+	_, err = s.UpdateTodo(context.Background(), 1, &modelgen.UpdateTodo{ClearDueAt: &clear}, nil)
+	assert.ErrorIs(t, err, ErrInvalidInput, "a recurring todo keeps its due date")
+
+	res, err = s.UpdateTodo(context.Background(), 1, &modelgen.UpdateTodo{ClearRecurrence: &clear, Recurrence: &rule}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, res.Recurrence)
+}
+
+func TestSetTodoDoneSpawnsNextOccurrence(t *testing.T) {
+	due := time.Now().Add(48 * time.Hour).Truncate(time.Second).UTC()
+	remindAt := due.Add(-time.Hour)
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model: gorm.Model{ID: 1}, TenantID: "acme", Title: "chores", DueAt: &due, RemindAt: &remindAt,
+			Recurrence: "FREQ=WEEKLY", RecurrenceTZ: "UTC", RecurrenceStart: &due, Version: 1,
+		},
+	}}
+	s := newServiceTodo(todoRepo, &testutil.MockRepo[model.User]{})
+	res, err := s.SetTodoDone(context.Background(), 1, true, nil)
+	require.NoError(t, err)
+	assert.True(t, res.Done)
+
+	require.Len(t, todoRepo.Created, 1)
+	next := todoRepo.Created[0]
+	// completing early keeps the following occurrence
+	assert.Equal(t, due.AddDate(0, 0, 7), *next.DueAt)
+	assert.Equal(t, remindAt.AddDate(0, 0, 7), *next.RemindAt)
+	assert.Equal(t, "acme", next.TenantID)
+	assert.Equal(t, "FREQ=WEEKLY", next.Recurrence)
+	assert.False(t, next.Done)
+
+	// the occurrence is spawned once
+	_, err = s.SetTodoDone(context.Background(), 1, false, nil)
+	require.NoError(t, err)
+	_, err = s.SetTodoDone(context.Background(), 1, true, nil)
+	require.NoError(t, err)
+	assert.Len(t, todoRepo.Created, 1)
+}
+
+func TestSetTodoDoneRollsBackWhenSpawnFails(t *testing.T) {
+	due := time.Now().Add(48 * time.Hour).UTC()
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model: gorm.Model{ID: 1}, Title: "chores", DueAt: &due,
+			Recurrence: "FREQ=WEEKLY", RecurrenceTZ: "Mars/Olympus_Mons", Version: 1,
+		},
+	}}
+	s := newServiceTodo(todoRepo, &testutil.MockRepo[model.User]{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := s.SubscribeTodoChanges(ctx, nil)
+	require.NoError(t, err)
+
+	_, err = s.SetTodoDone(context.Background(), 1, true, nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, todoRepo.RolledBack, "the todo is saved in the same transaction")
+	assert.Empty(t, todoRepo.Created)
+	assert.Empty(t, events)
+}
+
+func TestSpawnDueRecurrences(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	// Saturday before clocks go forward on 26 March 2023
+	due := time.Date(2023, 3, 25, 10, 0, 0, 0, berlin)
+	weekly := &model.Todo{Model: gorm.Model{ID: 1}, Title: "chores", DueAt: &due,
+		Recurrence: "FREQ=WEEKLY", RecurrenceTZ: "Europe/Berlin", RecurrenceStart: &due}
+	overdue := &model.Todo{Model: gorm.Model{ID: 2}, Title: "water plants", DueAt: &due,
+		Recurrence: "FREQ=DAILY", RecurrenceTZ: "Europe/Berlin", RecurrenceStart: &due}
+	over := &model.Todo{Model: gorm.Model{ID: 3}, Title: "once more", DueAt: &due,
+		Recurrence: "FREQ=DAILY;COUNT=1", RecurrenceStart: &due}
+	todoRepo := &testutil.MockTodoRepo{
+		MockRepo:    &testutil.MockRepo[model.Todo]{},
+		Recurrences: []*model.Todo{weekly, overdue, over},
+	}
+	s := newServiceTodo(todoRepo, &testutil.MockRepo[model.User]{})
+	now := time.Date(2023, 3, 28, 12, 0, 0, 0, berlin)
+	n, err := s.SpawnDueRecurrences(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	require.Len(t, todoRepo.Created, 2)
+
+	// the wall clock time is kept across the DST transition
+	assert.Equal(t, time.Date(2023, 4, 1, 10, 0, 0, 0, berlin), *todoRepo.Created[0].DueAt)
+	assert.Equal(t, "2023-04-01T08:00:00Z", todoRepo.Created[0].DueAt.UTC().Format(time.RFC3339))
+	// missed occurrences are skipped
+	assert.Equal(t, time.Date(2023, 3, 29, 10, 0, 0, 0, berlin), *todoRepo.Created[1].DueAt)
+	// a finished series is marked so that it is not loaded again
+	assert.NotNil(t, over.RecurredAt)
+
+	n, err = s.SpawnDueRecurrences(context.Background(), now)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestPreviewRecurrence(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	tz := "America/New_York"
+	start := time.Date(2023, 3, 10, 14, 0, 0, 0, time.UTC) // 09:00 EST
+	res, err := s.PreviewRecurrence(context.Background(), "FREQ=DAILY;COUNT=5", start, &tz, 3)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, "2023-03-12T09:00:00-04:00", res[2].Format(time.RFC3339))
+
+	res, err = s.PreviewRecurrence(context.Background(), "FREQ=DAILY;COUNT=2", start, nil, 5)
+	require.NoError(t, err)
+	assert.Len(t, res, 2)
+
+	_, err = s.PreviewRecurrence(context.Background(), "FREQ=DAILY", start, &tz, 0)
+	require.NoError(t, err)
+	_, err = s.PreviewRecurrence(context.Background(), "BYDAY=MO", start, nil, 5)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
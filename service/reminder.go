@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"go-graph/graph/modelgen"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// reminderBatchSize is how many reminders are claimed per query.
+const reminderBatchSize = 100
+
+// Notifier delivers a due reminder to the owner of a todo.
+type Notifier interface {
+	Notify(ctx context.Context, todo *modelgen.Todo) error
+}
+
+// LogNotifier writes reminders to the server log. It is the default until a
+// real delivery channel is configured.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(ctx context.Context, todo *modelgen.Todo) error {
+	e := log.Info().Int("todo_id", todo.ID).Str("text", todo.Text)
+	if todo.UserID != nil {
+		e = e.Int("user_id", *todo.UserID)
+	}
+	if todo.DueAt != nil {
+		e = e.Time("due_at", *todo.DueAt)
+	}
+	e.Msg("todo reminder")
+	return nil
+}
+
+// SendDueReminders hands every reminder due at now to notifier and returns
+// how many were delivered. Reminders are claimed in the database before
+// delivery, so each fires once even with several servers running; a failed
+// delivery releases its claim and is retried on the next run.
+func (s *ServiceTodo) SendDueReminders(ctx context.Context, notifier Notifier, now time.Time) (int, error) {
+	sent := 0
+	for {
+		todos, err := s.repo.ClaimDueReminders(ctx, now, reminderBatchSize)
+		if err != nil {
+			return sent, err
+		}
+		failed := false
+		for _, t := range todos {
+			if err := notifier.Notify(ctx, toTodo(t)); err != nil {
+				failed = true
+				log.Err(err).Uint("todo_id", t.ID).Msg("send reminder error")
+				if err := s.repo.ReleaseReminder(ctx, t.ID); err != nil {
+					return sent, err
+				}
+				continue
+			}
+			sent++
+		}
+		// released reminders would be claimed again right away, so a batch
+		// with failures ends the run
+		if len(todos) < reminderBatchSize || failed || ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+	}
+}
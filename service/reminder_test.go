@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	testutil "go-graph/test"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type recordingNotifier struct {
+	sent []int
+	fail map[int]bool
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, todo *modelgen.Todo) error {
+	if n.fail[todo.ID] {
+		return errors.New("unreachable")
+	}
+	n.sent = append(n.sent, todo.ID)
+	return nil
+}
+
+func TestSendDueReminders(t *testing.T) {
+	now := time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC)
+	todoRepo := &testutil.MockTodoRepo{
+		MockRepo: &testutil.MockRepo[model.Todo]{},
+		Reminders: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "call mom", RemindAt: &now},
+			{Model: gorm.Model{ID: 2}, Title: "pay rent", RemindAt: &now},
+		},
+	}
+	s := newServiceTodo(todoRepo, &testutil.MockRepo[model.User]{})
+	notifier := &recordingNotifier{fail: map[int]bool{2: true}}
+
+	n, err := s.SendDueReminders(context.Background(), notifier, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []int{1}, notifier.sent)
+	assert.Equal(t, []uint{2}, todoRepo.Released)
+
+	// claimed reminders are not handed out again
+	n, err = s.SendDueReminders(context.Background(), notifier, now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, []int{1}, notifier.sent)
+}
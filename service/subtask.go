@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+
+	"gorm.io/gorm"
+)
+
+// maxTodoDepth is how deep subtasks may nest; a top-level todo has depth 1.
+const maxTodoDepth = 5
+
+// TodoProgress counts the direct children of a todo.
+type TodoProgress struct {
+	Completed int
+	Total     int
+}
+
+// SetTodoParent moves a todo under parentID, or to the top level when
+// parentID is nil.
+func (s *ServiceTodo) SetTodoParent(ctx context.Context, id int, parentID *int, expectedVersion *int) (*modelgen.Todo, error) {
+	todo, err := s.findTodo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
+	todo.ParentID = nil
+	if parentID != nil {
+		parent, err := s.checkParent(ctx, todo, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		todo.ParentID = &parent
+	}
+	res, err := saveTodo(ctx, s.repo, todo)
+	if err != nil {
+		return nil, err
+	}
+	return s.publish(ctx, modelgen.TodoEventKindUpdated, res), nil
+}
+
+// GetChildrenByTodoIds loads the direct children of several todos in one
+// query. The result is aligned with ids and leaves out children outside the
+// caller's scope.
+func (s *ServiceTodo) GetChildrenByTodoIds(ctx context.Context, ids []int) ([][]*modelgen.Todo, error) {
+	children := make([][]*modelgen.Todo, len(ids))
+	if len(ids) == 0 {
+		return children, nil
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repo.FindChildren(ctx, toUintIds(ids))
+	if err != nil {
+		return nil, err
+	}
+	byParent := make(map[int][]*modelgen.Todo, len(ids))
+	for _, v := range res {
+		if !inScope(scope, v) {
+			continue
+		}
+		parent := int(*v.ParentID)
+		byParent[parent] = append(byParent[parent], toTodo(v))
+	}
+	for i, id := range ids {
+		children[i] = byParent[id]
+		if children[i] == nil {
+			children[i] = []*modelgen.Todo{}
+		}
+	}
+	return children, nil
+}
+
+// GetProgressByTodoIds counts the children of several todos in one query.
+// The result is aligned with ids and never holds nil; like
+// GetChildrenByTodoIds it leaves out children outside the caller's scope.
+func (s *ServiceTodo) GetProgressByTodoIds(ctx context.Context, ids []int) ([]*TodoProgress, error) {
+	progress := make([]*TodoProgress, len(ids))
+	for i := range progress {
+		progress[i] = &TodoProgress{}
+	}
+	if len(ids) == 0 {
+		return progress, nil
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repo.CountChildren(ctx, toUintIds(ids), scope)
+	if err != nil {
+		return nil, err
+	}
+	byParent := make(map[int]*model.ChildCount, len(res))
+	for _, v := range res {
+		byParent[int(v.ParentID)] = v
+	}
+	for i, id := range ids {
+		if v, ok := byParent[id]; ok {
+			progress[i] = &TodoProgress{Completed: v.Completed, Total: v.Total}
+		}
+	}
+	return progress, nil
+}
+
+// checkParent validates parentID as the parent of todo, which is nil for a
+// todo that is about to be created. It rejects parents that would make todo
+// its own ancestor or nest its subtree deeper than maxTodoDepth.
+func (s *ServiceTodo) checkParent(ctx context.Context, todo *model.Todo, parentID int) (uint, error) {
+	if parentID <= 0 {
+		return 0, fmt.Errorf("%w: parent %d does not exist", ErrInvalidInput, parentID)
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if scope != nil {
+		// only the caller's own todos can take subtasks
+		if _, err := s.findTodo(ctx, parentID); err != nil {
+			return 0, err
+		}
+	}
+	lineage, err := s.repo.FindLineage(ctx, uint(parentID), maxTodoDepth+1)
+	if err != nil {
+		return 0, err
+	}
+	if len(lineage) == 0 {
+		return 0, fmt.Errorf("%w: parent %d does not exist", ErrInvalidInput, parentID)
+	}
+	height := 0
+	if todo != nil {
+		for _, id := range lineage {
+			if id == todo.ID {
+				return 0, fmt.Errorf("%w: todo %d cannot be nested under its own subtask", ErrInvalidInput, todo.ID)
+			}
+		}
+		if height, err = s.repo.SubtreeDepth(ctx, todo.ID, maxTodoDepth); err != nil {
+			return 0, err
+		}
+	}
+	if len(lineage)+1+height > maxTodoDepth {
+		return 0, fmt.Errorf("%w: subtasks can nest at most %d levels deep", ErrInvalidInput, maxTodoDepth)
+	}
+	return uint(parentID), nil
+}
+
+// completeParents walks up from parentID through repo marking
+// auto-completing parents done once all of their children are done. It
+// returns the parents it completed, left to the caller to publish once the
+// writes are committed.
+func (s *ServiceTodo) completeParents(ctx context.Context, repo model.TodoRepo, parentID *uint) ([]*model.Todo, error) {
+	var completed []*model.Todo
+	for depth := 0; parentID != nil && depth < maxTodoDepth; depth++ {
+		parent, err := repo.FindById(ctx, *parentID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// the parent is in the trash; there is nothing to roll up into
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !parent.AutoComplete || parent.Done {
+			break
+		}
+		counts, err := repo.CountChildren(ctx, []uint{parent.ID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(counts) == 0 || counts[0].Completed < counts[0].Total {
+			break
+		}
+		parent.Done = true
+		res, err := repo.Update(ctx, parent)
+		if errors.Is(err, model.ErrVersionConflict) {
+			// the parent was edited meanwhile; leave it to that edit
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		completed = append(completed, res)
+		parentID = parent.ParentID
+	}
+	return completed, nil
+}
+
+func toUintIds(ids []int) []uint {
+	keys := make([]uint, len(ids))
+	for i, id := range ids {
+		keys[i] = uint(id)
+	}
+	return keys
+}
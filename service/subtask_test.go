@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// chain builds todos 1..n where each todo is the child of the previous one.
+func chain(n int) []*model.Todo {
+	todos := make([]*model.Todo, n)
+	for i := range todos {
+		todos[i] = &model.Todo{Model: gorm.Model{ID: uint(i + 1)}, Title: "step"}
+		if i > 0 {
+			parent := uint(i)
+			todos[i].ParentID = &parent
+		}
+	}
+	return todos
+}
+
+func newSubtaskService(tree []*model.Todo) (*ServiceTodo, *testutil.MockTodoRepo) {
+	repo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}, Tree: tree}
+	return newServiceTodo(repo, &testutil.MockRepo[model.User]{}), repo
+}
+
+func TestSetTodoParent(t *testing.T) {
+	tree := append(chain(2), &model.Todo{Model: gorm.Model{ID: 3}, Title: "loose"})
+	s, _ := newSubtaskService(tree)
+	parent := 2
+	res, err := s.SetTodoParent(context.Background(), 3, &parent, nil)
+	require.NoError(t, err)
+	require.NotNil(t, res.ParentID)
+	assert.Equal(t, 2, *res.ParentID)
+
+	res, err = s.SetTodoParent(context.Background(), 3, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, res.ParentID)
+}
+
+func TestSetTodoParentRejectsCycle(t *testing.T) {
+	s, _ := newSubtaskService(chain(3))
+	for _, parent := range []int{1, 3} {
+		_, err := s.SetTodoParent(context.Background(), 1, &parent, nil)
+		assert.ErrorIs(t, err, ErrInvalidInput, "parent %d", parent)
+	}
+}
+
+func TestSetTodoParentRejectsDepth(t *testing.T) {
+	// todo 6 has one child, so hanging it under the depth 4 todo would
+	// nest the child at depth 6
+	tree := append(chain(maxTodoDepth-1),
+		&model.Todo{Model: gorm.Model{ID: 6}},
+		&model.Todo{Model: gorm.Model{ID: 7}, ParentID: uintPtr(6)},
+	)
+	s, _ := newSubtaskService(tree)
+	parent := maxTodoDepth - 1
+	_, err := s.SetTodoParent(context.Background(), 6, &parent, nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = s.SetTodoParent(context.Background(), 7, &parent, nil)
+	assert.NoError(t, err)
+}
+
+func TestNewTodoUnknownParent(t *testing.T) {
+	s, _ := newSubtaskService(chain(1))
+	userRepo := &testutil.MockRepo[model.User]{Model: &model.User{Model: gorm.Model{ID: 1}}}
+	s.userRepo = userRepo
+	parent := 9
+	_, err := s.NewTodo(context.Background(), &modelgen.NewTodo{Text: "sub", UserID: "1", ParentID: &parent})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestCompletingChildrenCompletesParent(t *testing.T) {
+	tree := chain(2)
+	tree[0].AutoComplete = true
+	tree = append(tree, &model.Todo{Model: gorm.Model{ID: 3}, ParentID: uintPtr(1)})
+	s, _ := newSubtaskService(tree)
+
+	_, err := s.SetTodoDone(context.Background(), 2, true, nil)
+	require.NoError(t, err)
+	assert.False(t, tree[0].Done, "a child is still open")
+
+	_, err = s.SetTodoDone(context.Background(), 3, true, nil)
+	require.NoError(t, err)
+	assert.True(t, tree[0].Done)
+}
+
+func TestGetProgressByTodoIds(t *testing.T) {
+	tree := append(chain(2), &model.Todo{Model: gorm.Model{ID: 3}, ParentID: uintPtr(1), Done: true})
+	s, _ := newSubtaskService(tree)
+	res, err := s.GetProgressByTodoIds(context.Background(), []int{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, []*TodoProgress{{Completed: 1, Total: 2}, {}}, res)
+}
+
+func TestCompletingChildOfTrashedParent(t *testing.T) {
+	// the parent 9 is in the trash and not found any more
+	tree := []*model.Todo{{Model: gorm.Model{ID: 2}, ParentID: uintPtr(9)}}
+	s, _ := newSubtaskService(tree)
+	res, err := s.SetTodoDone(context.Background(), 2, true, nil)
+	require.NoError(t, err)
+	assert.True(t, res.Done)
+}
+
+func TestGetProgressByTodoIdsIsScopedToCaller(t *testing.T) {
+	tree := []*model.Todo{
+		{Model: gorm.Model{ID: 1}, UserID: uintPtr(1)},
+		{Model: gorm.Model{ID: 2}, UserID: uintPtr(1), ParentID: uintPtr(1)},
+		{Model: gorm.Model{ID: 3}, UserID: uintPtr(2), ParentID: uintPtr(1), Done: true},
+	}
+	s, _ := newSubtaskService(tree)
+	res, err := s.GetProgressByTodoIds(client(alice), []int{1})
+	require.NoError(t, err)
+	assert.Equal(t, []*TodoProgress{{Completed: 0, Total: 1}}, res)
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
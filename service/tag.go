@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const maxTagNameLength = 50
+
+type ServiceTag struct {
+	repo model.TagRepo
+}
+
+func NewServiceTag(repo model.TagRepo) *ServiceTag {
+	return &ServiceTag{
+		repo: repo,
+	}
+}
+
+func (s *ServiceTag) GetTags(ctx context.Context) ([]*modelgen.Tag, error) {
+	res, err := s.repo.FindAllByIds(ctx, []any{})
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*modelgen.Tag, len(res))
+	for i, v := range res {
+		tags[i] = toTag(v)
+	}
+	return tags, nil
+}
+
+func (s *ServiceTag) RenameTag(ctx context.Context, id int, name string) (*modelgen.Tag, error) {
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	if id <= 0 {
+		return nil, ErrTagNotFound
+	}
+	tag, err := s.repo.FindById(ctx, uint(id))
+	if err != nil {
+		return nil, notFound(err, ErrTagNotFound)
+	}
+	if tag.Name == name {
+		return toTag(tag), nil
+	}
+	existing, err := s.repo.FindByName(ctx, name)
+	switch {
+	case err == nil && existing.ID != tag.ID:
+		return nil, fmt.Errorf("%w: %q", ErrTagExists, name)
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+	tag.Name = name
+	res, err := s.repo.Update(ctx, tag)
+	if errors.Is(err, model.ErrDuplicateKey) {
+		// another tag took the name since it was checked
+		return nil, fmt.Errorf("%w: %q", ErrTagExists, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toTag(res), nil
+}
+
+// GetTagsByTodoIds loads the tags of several todos in one query. The result
+// is aligned with todoIDs.
+func (s *ServiceTag) GetTagsByTodoIds(ctx context.Context, todoIDs []int) ([][]*modelgen.Tag, error) {
+	tags := make([][]*modelgen.Tag, len(todoIDs))
+	if len(todoIDs) == 0 {
+		return tags, nil
+	}
+	keys := make([]uint, len(todoIDs))
+	for i, id := range todoIDs {
+		keys[i] = uint(id)
+	}
+	res, err := s.repo.FindByTodoIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	byTodo := make(map[int][]*modelgen.Tag, len(todoIDs))
+	for _, v := range res {
+		byTodo[int(v.TodoID)] = append(byTodo[int(v.TodoID)], toTag(&v.Tag))
+	}
+	for i, id := range todoIDs {
+		tags[i] = byTodo[id]
+		if tags[i] == nil {
+			tags[i] = []*modelgen.Tag{}
+		}
+	}
+	return tags, nil
+}
+
+// normalizeTagName trims and lower-cases name so "Work" and "work " are the
+// same tag.
+func normalizeTagName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", fmt.Errorf("%w: tag name must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(name) > maxTagNameLength {
+		return "", fmt.Errorf("%w: tag name must be at most %d characters", ErrInvalidInput, maxTagNameLength)
+	}
+	return name, nil
+}
+
+// normalizeTagNames normalizes and de-duplicates a tag filter.
+func normalizeTagNames(names []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(names))
+	res := make([]string, 0, len(names))
+	for _, n := range names {
+		name, err := normalizeTagName(n)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		res = append(res, name)
+	}
+	return res, nil
+}
+
+func toTag(m *model.Tag) *modelgen.Tag {
+	return &modelgen.Tag{ID: int(m.ID), Name: m.Name}
+}
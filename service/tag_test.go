@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRenameTag(t *testing.T) {
+	mockRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{
+		Model: &model.Tag{Model: gorm.Model{ID: 1}, Name: "work"},
+	}}
+	s := NewServiceTag(mockRepo)
+	res, err := s.RenameTag(context.Background(), 1, "work")
+	require.NoError(t, err)
+	assert.Equal(t, "work", res.Name)
+}
+
+func TestRenameTagConflict(t *testing.T) {
+	mockRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{
+		Model:  &model.Tag{Model: gorm.Model{ID: 1}, Name: "work"},
+		Models: []*model.Tag{{Model: gorm.Model{ID: 2}, Name: "office"}},
+	}}
+	s := NewServiceTag(mockRepo)
+	_, err := s.RenameTag(context.Background(), 1, " Office ")
+	assert.ErrorIs(t, err, ErrTagExists)
+}
+
+func TestRenameTagConcurrentConflict(t *testing.T) {
+	mockRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{
+		Model:     &model.Tag{Model: gorm.Model{ID: 1}, Name: "work"},
+		Models:    []*model.Tag{},
+		UpdateErr: model.ErrDuplicateKey,
+	}}
+	s := NewServiceTag(mockRepo)
+	_, err := s.RenameTag(context.Background(), 1, "office")
+	assert.ErrorIs(t, err, ErrTagExists)
+}
+
+func TestGetTagsByTodoIds(t *testing.T) {
+	mockRepo := &testutil.MockTagRepo{
+		MockRepo: &testutil.MockRepo[model.Tag]{},
+		TodoTags: []*model.TodoTag{
+			{Tag: model.Tag{Model: gorm.Model{ID: 1}, Name: "urgent"}, TodoID: 3},
+			{Tag: model.Tag{Model: gorm.Model{ID: 2}, Name: "work"}, TodoID: 3},
+		},
+	}
+	s := NewServiceTag(mockRepo)
+	res, err := s.GetTagsByTodoIds(context.Background(), []int{1, 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	assert.Empty(t, res[0])
+	require.Equal(t, 2, len(res[1]))
+	assert.Equal(t, "urgent", res[1][0].Name)
+	assert.Equal(t, "work", res[1][1].Name)
+}
+
+func TestNormalizeTagNames(t *testing.T) {
+	names, err := normalizeTagNames([]string{"Work", " work", "urgent"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work", "urgent"}, names)
+
+	_, err = normalizeTagNames([]string{" "})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
@@ -2,45 +2,763 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"go-graph/db/model"
 	"go-graph/graph/modelgen"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/auth"
+	"go-graph/pkg/pubsub"
+	"go-graph/pkg/storage"
+	"go-graph/pkg/tenant"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	maxTodoTextLength    = 1000
+	maxSearchQueryLength = 256
 )
 
 type ServiceTodo struct {
-	repo model.TodoRepo
+	repo        model.TodoRepo
+	userRepo    model.UserRepo
+	tagRepo     model.TagRepo
+	projectRepo model.ProjectRepo
+	events      *pubsub.Broker[*modelgen.TodoEvent]
+	// files holds the attachment blobs removed when todos are purged.
+	files storage.Storage
 }
 
-func NewServiceTodo(repo model.TodoRepo) *ServiceTodo {
+func NewServiceTodo(repo model.TodoRepo, userRepo model.UserRepo, tagRepo model.TagRepo, projectRepo model.ProjectRepo, events *pubsub.Broker[*modelgen.TodoEvent], files storage.Storage) *ServiceTodo {
 	return &ServiceTodo{
-		repo: repo,
+		repo:        repo,
+		userRepo:    userRepo,
+		tagRepo:     tagRepo,
+		projectRepo: projectRepo,
+		events:      events,
+		files:       files,
 	}
 }
 
 func (s *ServiceTodo) NewTodo(ctx context.Context, input *modelgen.NewTodo) (*modelgen.Todo, error) {
-	res, err := s.repo.Create(&model.Todo{
-		Title: input.Text,
+	todo, err := s.buildTodo(ctx, input, map[string]uint{})
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repo.Create(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+	return s.publish(ctx, modelgen.TodoEventKindCreated, res), nil
+}
+
+// buildTodo validates input and turns it into a new model. owners caches
+// the users already looked up by findOwner.
+func (s *ServiceTodo) buildTodo(ctx context.Context, input *modelgen.NewTodo, owners map[string]uint) (*model.Todo, error) {
+	text, err := validateTodoText(input.Text)
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := owners[input.UserID]
+	if !ok {
+		if userID, err = s.findOwner(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+		owners[input.UserID] = userID
+	}
+	todo := &model.Todo{
+		Title:    text,
+		UserID:   &userID,
+		DueAt:    input.DueAt,
+		RemindAt: input.RemindAt,
+	}
+	if input.Priority != nil {
+		todo.Priority = toModelPriority(*input.Priority)
+	}
+	if input.AutoComplete != nil {
+		todo.AutoComplete = *input.AutoComplete
+	}
+	if input.ParentID != nil {
+		parent, err := s.checkParent(ctx, nil, *input.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		todo.ParentID = &parent
+	}
+	if input.ProjectID != nil {
+		project, err := s.checkProject(ctx, *input.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		todo.ProjectID = &project
+	}
+	if input.Recurrence != nil {
+		if err := setRecurrence(todo, *input.Recurrence, input.RecurrenceTimeZone); err != nil {
+			return nil, err
+		}
+	} else if input.RecurrenceTimeZone != nil {
+		return nil, fmt.Errorf("%w: recurrenceTimeZone must be given with recurrence", ErrInvalidInput)
+	}
+	return todo, nil
+}
+
+// UpdateTodo applies input to a todo. When expectedVersion is set and the
+// todo has moved past it, the update fails with a conflict. Completing the
+// todo spawns its next occurrence and rolls up into its parents in the same
+// transaction.
+func (s *ServiceTodo) UpdateTodo(ctx context.Context, id int, input *modelgen.UpdateTodo, expectedVersion *int) (*modelgen.Todo, error) {
+	todo, err := s.findTodo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
+	completed, err := applyUpdate(todo, input)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		res      *model.Todo
+		followUp *completion
+	)
+	err = s.repo.Transaction(ctx, func(tx model.TodoRepo) error {
+		if res, err = saveTodo(ctx, tx, todo); err != nil {
+			return err
+		}
+		if completed {
+			followUp, err = s.complete(ctx, tx, res)
+		}
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &modelgen.Todo{ID: int(res.ID), Text: res.Title, Done: res.Done}, nil
+	updated := s.publish(ctx, modelgen.TodoEventKindUpdated, res)
+	s.publishCompletion(ctx, followUp)
+	return updated, nil
+}
+
+// completion holds the todos written because a todo was completed: the
+// next occurrence of its series, if any, and the parents it completed.
+type completion struct {
+	spawned *model.Todo
+	parents []*model.Todo
+}
+
+// complete spawns the next occurrence of the just completed todo and rolls
+// it up into its parents through repo.
+func (s *ServiceTodo) complete(ctx context.Context, repo model.TodoRepo, todo *model.Todo) (*completion, error) {
+	spawned, err := s.spawnNext(ctx, repo, todo, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	parents, err := s.completeParents(ctx, repo, todo.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	return &completion{spawned: spawned, parents: parents}, nil
+}
+
+// publishCompletion announces the todos written by complete; c may be nil.
+func (s *ServiceTodo) publishCompletion(ctx context.Context, c *completion) {
+	if c == nil {
+		return
+	}
+	if c.spawned != nil {
+		s.publish(ctx, modelgen.TodoEventKindCreated, c.spawned)
+	}
+	for _, p := range c.parents {
+		s.publish(ctx, modelgen.TodoEventKindUpdated, p)
+	}
+}
+
+// applyUpdate copies the set fields of input onto todo and reports whether
+// the update completes it.
+func applyUpdate(todo *model.Todo, input *modelgen.UpdateTodo) (bool, error) {
+	if input.Text != nil {
+		text, err := validateTodoText(*input.Text)
+		if err != nil {
+			return false, err
+		}
+		todo.Title = text
+	}
+	completed := input.Done != nil && *input.Done && !todo.Done
+	if input.Done != nil {
+		todo.Done = *input.Done
+	}
+	if input.AutoComplete != nil {
+		todo.AutoComplete = *input.AutoComplete
+	}
+	if input.Priority != nil {
+		todo.Priority = toModelPriority(*input.Priority)
+	}
+	if input.DueAt != nil {
+		todo.DueAt = input.DueAt
+	}
+	if input.ClearDueAt != nil && *input.ClearDueAt {
+		todo.DueAt = nil
+	}
+	if input.RemindAt != nil {
+		todo.RemindAt = input.RemindAt
+		todo.RemindedAt = nil
+	}
+	if input.ClearRemindAt != nil && *input.ClearRemindAt {
+		todo.RemindAt = nil
+		todo.RemindedAt = nil
+	}
+	switch {
+	case input.ClearRecurrence != nil && *input.ClearRecurrence:
+		clearRecurrence(todo)
+	case input.Recurrence != nil:
+		if err := setRecurrence(todo, *input.Recurrence, input.RecurrenceTimeZone); err != nil {
+			return false, err
+		}
+	case input.RecurrenceTimeZone != nil:
+		return false, fmt.Errorf("%w: recurrenceTimeZone must be given with recurrence", ErrInvalidInput)
+	}
+	if todo.Recurrence != "" && todo.DueAt == nil {
+		return false, fmt.Errorf("%w: a recurring todo needs a due date", ErrInvalidInput)
+	}
+	return completed, nil
+}
+
+func (s *ServiceTodo) SetTodoDone(ctx context.Context, id int, done bool, expectedVersion *int) (*modelgen.Todo, error) {
+	return s.UpdateTodo(ctx, id, &modelgen.UpdateTodo{Done: &done}, expectedVersion)
+}
+
+// DeleteTodo soft-deletes a todo and returns it as it was before deletion.
+func (s *ServiceTodo) DeleteTodo(ctx context.Context, id int) (*modelgen.Todo, error) {
+	todo, err := s.findTodo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Delete(ctx, todo); err != nil {
+		return nil, err
+	}
+	return s.publish(ctx, modelgen.TodoEventKindDeleted, todo), nil
+}
+
+func (s *ServiceTodo) RestoreTodo(ctx context.Context, id int) (*modelgen.Todo, error) {
+	if err := s.authorizeTrashed(ctx, id); err != nil {
+		return nil, err
+	}
+	res, err := s.repo.Restore(ctx, uint(id))
+	if err != nil {
+		return nil, notFound(err, ErrTodoNotFound)
+	}
+	return s.publish(ctx, modelgen.TodoEventKindUpdated, res), nil
+}
+
+// SearchTodos ranks todos by how well their title matches query. Results are
+// paged with offset cursors because rank order has no stable keyset.
+func (s *ServiceTodo) SearchTodos(ctx context.Context, query string, first *int, after *string) (*modelgen.TodoSearchConnection, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: query must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, fmt.Errorf("%w: query must be at most %d characters", ErrInvalidInput, maxSearchQueryLength)
+	}
+	limit := defaultPageSize
+	if first != nil {
+		if *first < 0 || *first > maxPageSize {
+			return nil, fmt.Errorf("%w: first must be between 0 and %d", ErrInvalidInput, maxPageSize)
+		}
+		limit = *first
+	}
+	offset := 0
+	if after != nil {
+		pos, err := decodeOffsetCursor(*after)
+		if err != nil {
+			return nil, fmt.Errorf("%w: after: %v", ErrInvalidInput, err)
+		}
+		offset = pos + 1
+	}
+	conn := &modelgen.TodoSearchConnection{
+		Edges:    []*modelgen.TodoSearchHit{},
+		PageInfo: &modelgen.PageInfo{HasPreviousPage: offset > 0},
+	}
+	if limit == 0 {
+		return conn, nil
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := s.repo.Search(ctx, query, scope, limit+1, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(hits) > limit {
+		hits = hits[:limit]
+		conn.PageInfo.HasNextPage = true
+	}
+	conn.Edges = make([]*modelgen.TodoSearchHit, len(hits))
+	for i, h := range hits {
+		conn.Edges[i] = &modelgen.TodoSearchHit{
+			Node:    toTodo(&h.Todo),
+			Cursor:  encodeOffsetCursor(offset + i),
+			Rank:    h.Rank,
+			Snippet: h.Snippet,
+		}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// PurgeTodo permanently removes a todo that is already in the trash along
+// with its comments and attachments.
+func (s *ServiceTodo) PurgeTodo(ctx context.Context, id int) (bool, error) {
+	if err := s.authorizeTrashed(ctx, id); err != nil {
+		return false, err
+	}
+	if id <= 0 {
+		return false, ErrTodoNotFound
+	}
+	keys, err := s.repo.PurgeTodo(ctx, uint(id))
+	if err != nil {
+		return false, notFound(err, ErrTodoNotFound)
+	}
+	s.deleteFiles(ctx, keys)
+	return true, nil
+}
+
+// GetTrashedTodos lists soft-deleted todos, most recently deleted first.
+func (s *ServiceTodo) GetTrashedTodos(ctx context.Context) ([]*modelgen.Todo, error) {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repo.FindTrashed(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	todos := make([]*modelgen.Todo, len(res))
+	for i, v := range res {
+		todos[i] = toTodo(v)
+	}
+	return todos, nil
+}
+
+// PurgeExpiredTodos permanently removes todos that have been in the trash
+// for longer than retention.
+func (s *ServiceTodo) PurgeExpiredTodos(ctx context.Context, retention time.Duration) (int64, error) {
+	n, keys, err := s.repo.PurgeTodosDeletedBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+	s.deleteFiles(ctx, keys)
+	return n, nil
+}
+
+// deleteFiles removes the blobs of purged attachments. Their rows are gone
+// already, so a blob that cannot be removed is only logged.
+func (s *ServiceTodo) deleteFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.files.Delete(ctx, key); err != nil {
+			log.Err(err).Str("key", key).Msg("delete attachment blob error")
+		}
+	}
+}
+
+// SubscribeTodoChanges streams todo events of the caller's tenant until ctx
+// is done, limited to the todos of userID when it is set. Callers restricted
+// by todoScope only receive events about their own todos.
+func (s *ServiceTodo) SubscribeTodoChanges(ctx context.Context, userID *int) (<-chan *modelgen.TodoEvent, error) {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil {
+		if userID != nil && *userID != int(*scope) {
+			return nil, auth.ErrForbidden
+		}
+		owner := int(*scope)
+		userID = &owner
+	}
+	tenantID, scoped := tenant.FromContext(ctx)
+	filter := func(e *modelgen.TodoEvent) bool {
+		if scoped && e.Tenant != tenantID {
+			return false
+		}
+		return userID == nil || e.Todo.UserID != nil && *e.Todo.UserID == *userID
+	}
+	return s.events.Subscribe(ctx, filter), nil
 }
 
 func (s *ServiceTodo) GetTodo(ctx context.Context, id string) (*modelgen.Todo, error) {
-	res, err := s.repo.FindById(id)
+	n, _ := strconv.Atoi(id) // a malformed id is not found like any other
+	res, err := s.findTodo(ctx, n)
 	if err != nil {
 		return nil, err
 	}
-	return &modelgen.Todo{ID: int(res.ID), Text: res.Title, Done: res.Done}, nil
+	return toTodo(res), nil
 }
-func (s *ServiceTodo) GetTodos(ctx context.Context) ([]*modelgen.Todo, error) {
-	res, err := s.repo.FindAllByIds([]any{})
+
+// AddTag labels a todo, creating the tag on first use.
+func (s *ServiceTodo) AddTag(ctx context.Context, todoID int, name string) (*modelgen.Todo, error) {
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	todo, err := s.findTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := s.tagRepo.FindOrCreateByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.AddTags(ctx, todo, tag); err != nil {
+		return nil, lostRace(ctx, s.repo, todo.ID, err)
+	}
+	return s.publish(ctx, modelgen.TodoEventKindUpdated, todo), nil
+}
+
+// RemoveTag takes a label off a todo; the tag itself is kept.
+func (s *ServiceTodo) RemoveTag(ctx context.Context, todoID int, name string) (*modelgen.Todo, error) {
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	todo, err := s.findTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := s.tagRepo.FindByName(ctx, name)
+	if err != nil {
+		return nil, notFound(err, ErrTagNotFound)
+	}
+	if err := s.repo.RemoveTags(ctx, todo, tag); err != nil {
+		return nil, lostRace(ctx, s.repo, todo.ID, err)
+	}
+	return s.publish(ctx, modelgen.TodoEventKindUpdated, todo), nil
+}
+
+// GetTodosByIds loads todos in a single query. The result is aligned with
+// ids and holds nil where a todo does not exist or is out of the caller's
+// scope.
+func (s *ServiceTodo) GetTodosByIds(ctx context.Context, ids []int) ([]*modelgen.Todo, error) {
+	todos := make([]*modelgen.Todo, len(ids))
+	if len(ids) == 0 {
+		return todos, nil
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]any, len(ids))
+	for i, id := range ids {
+		keys[i] = uint(id)
+	}
+	res, err := s.repo.FindAllByIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*model.Todo, len(res))
+	for _, v := range res {
+		if inScope(scope, v) {
+			byID[int(v.ID)] = v
+		}
+	}
+	for i, id := range ids {
+		if v, ok := byID[id]; ok {
+			todos[i] = toTodo(v)
+		}
+	}
+	return todos, nil
+}
+
+// GetTodos lists todos matching filter in the requested order. userID
+// restricts the listing to one owner and takes precedence over filter.userId.
+// Callers restricted by todoScope may only list their own todos.
+func (s *ServiceTodo) GetTodos(ctx context.Context, userID *int, filter *modelgen.TodoFilter, orderBy *modelgen.TodoOrder) ([]*modelgen.Todo, error) {
+	f, err := toTodoFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if userID != nil {
+		owner := uint(*userID)
+		f.UserID = &owner
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil {
+		if f.UserID != nil && *f.UserID != *scope {
+			return nil, auth.ErrForbidden
+		}
+		f.UserID = scope
+	}
+	res, err := s.repo.FindFiltered(ctx, f, toTodoOrder(orderBy))
 	if err != nil {
 		return nil, err
 	}
 	todos := make([]*modelgen.Todo, len(res))
 	for i, v := range res {
-		todos[i] = &modelgen.Todo{ID: int(v.ID), Text: v.Title, Done: v.Done}
+		todos[i] = toTodo(v)
 	}
 	return todos, nil
 }
+
+// GetTodosConnection pages through todos ordered by creation time using
+// opaque (created_at, id) cursors. Todos of archived projects are left out.
+func (s *ServiceTodo) GetTodosConnection(ctx context.Context, first *int, after *string, last *int, before *string) (*modelgen.TodoConnection, error) {
+	req, err := pageRequest(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	conn := &modelgen.TodoConnection{Edges: []*modelgen.TodoEdge{}}
+	if req.First == 0 && req.Last == 0 {
+		conn.PageInfo = &modelgen.PageInfo{}
+		return conn, nil
+	}
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil {
+		req.Where = map[string]any{"user_id": *scope}
+	}
+	req.Scopes = append(req.Scopes, model.WithoutArchivedProjects)
+	page, err := s.repo.Paginate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	conn.Edges = make([]*modelgen.TodoEdge, len(page.Items))
+	for i, v := range page.Items {
+		conn.Edges[i] = &modelgen.TodoEdge{Node: toTodo(v), Cursor: page.Cursors[i].Encode()}
+	}
+	conn.PageInfo = toPageInfo(page)
+	return conn, nil
+}
+
+// publish notifies the subscribers of the todo's tenant about a successful
+// write and returns the todo converted so callers can hand it straight back
+// to the resolver.
+func (s *ServiceTodo) publish(ctx context.Context, kind modelgen.TodoEventKind, m *model.Todo) *modelgen.Todo {
+	owner := m.TenantID
+	if id, ok := tenant.FromContext(ctx); ok {
+		owner = id
+	}
+	todo := toTodo(m)
+	s.events.Publish(&modelgen.TodoEvent{Kind: kind, Todo: todo, Tenant: owner})
+	return todo
+}
+
+// findTodo loads a todo the caller may access. Callers restricted by
+// todoScope are denied alike whether the todo is missing or someone else's.
+func (s *ServiceTodo) findTodo(ctx context.Context, id int) (*model.Todo, error) {
+	scope, err := todoScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	missing := ErrTodoNotFound
+	if scope != nil {
+		missing = auth.ErrForbidden
+	}
+	if id <= 0 {
+		return nil, missing
+	}
+	res, err := s.repo.FindById(ctx, uint(id))
+	if err != nil {
+		return nil, notFound(err, missing)
+	}
+	if !inScope(scope, res) {
+		return nil, auth.ErrForbidden
+	}
+	return res, nil
+}
+
+// authorizeTrashed checks that a caller restricted by todoScope owns the
+// trashed todo id.
+func (s *ServiceTodo) authorizeTrashed(ctx context.Context, id int) error {
+	scope, err := todoScope(ctx)
+	if err != nil || scope == nil {
+		return err
+	}
+	if id <= 0 {
+		return auth.ErrForbidden
+	}
+	_, err = s.repo.FindTrashedById(ctx, uint(id), scope)
+	return notFound(err, auth.ErrForbidden)
+}
+
+// checkVersion fails with a conflict when expected is set and todo is at a
+// different version.
+func checkVersion(todo *model.Todo, expected *int) error {
+	if expected != nil && todo.Version != uint(*expected) {
+		return versionConflict(todo)
+	}
+	return nil
+}
+
+// saveTodo writes todo through repo, turning a lost race on the version
+// column into a conflict that carries the current state of the todo.
+func saveTodo(ctx context.Context, repo model.TodoRepo, todo *model.Todo) (*model.Todo, error) {
+	res, err := repo.Update(ctx, todo)
+	if err != nil {
+		return nil, lostRace(ctx, repo, todo.ID, err)
+	}
+	return res, nil
+}
+
+// lostRace turns model.ErrVersionConflict from a write of todo id into a
+// conflict that carries the current state of the todo; other errors are
+// returned as is.
+func lostRace(ctx context.Context, repo model.TodoRepo, id uint, err error) error {
+	if !errors.Is(err, model.ErrVersionConflict) {
+		return err
+	}
+	current, err := repo.FindById(ctx, id)
+	if err != nil {
+		return notFound(err, ErrTodoNotFound)
+	}
+	return versionConflict(current)
+}
+
+func versionConflict(current *model.Todo) error {
+	err := apperr.Errorf(apperr.CodeConflict, "todo %d was changed by someone else and is now at version %d", current.ID, current.Version)
+	err.Details = map[string]any{"current": toTodo(current)}
+	return err
+}
+
+// findOwner parses the user id of a NewTodo input and checks that the user exists.
+func (s *ServiceTodo) findOwner(ctx context.Context, userID string) (uint, error) {
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("%w: userId must be a positive integer", ErrInvalidInput)
+	}
+	if err := authorizeOwner(ctx, uint(id)); err != nil {
+		return 0, err
+	}
+	if _, err := s.userRepo.FindById(ctx, uint(id)); err != nil {
+		return 0, notFound(err, ErrUserNotFound)
+	}
+	return uint(id), nil
+}
+
+func validateTodoText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("%w: text must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(text) > maxTodoTextLength {
+		return "", fmt.Errorf("%w: text must be at most %d characters", ErrInvalidInput, maxTodoTextLength)
+	}
+	return text, nil
+}
+
+// todoOrderColumns maps the GraphQL sort fields onto model columns.
+var todoOrderColumns = map[modelgen.TodoOrderField]string{
+	modelgen.TodoOrderFieldCreatedAt: "created_at",
+	modelgen.TodoOrderFieldUpdatedAt: "updated_at",
+	modelgen.TodoOrderFieldText:      "title",
+	modelgen.TodoOrderFieldDone:      "done",
+	modelgen.TodoOrderFieldDueAt:     "due_at",
+	modelgen.TodoOrderFieldPriority:  "priority",
+}
+
+var todoPriorities = map[modelgen.TodoPriority]model.Priority{
+	modelgen.TodoPriorityNone:   model.PriorityNone,
+	modelgen.TodoPriorityLow:    model.PriorityLow,
+	modelgen.TodoPriorityMedium: model.PriorityMedium,
+	modelgen.TodoPriorityHigh:   model.PriorityHigh,
+}
+
+func toModelPriority(p modelgen.TodoPriority) model.Priority {
+	return todoPriorities[p]
+}
+
+func toTodoPriority(p model.Priority) modelgen.TodoPriority {
+	for k, v := range todoPriorities {
+		if v == p {
+			return k
+		}
+	}
+	return modelgen.TodoPriorityNone
+}
+
+func toTodoFilter(f *modelgen.TodoFilter) (model.TodoFilter, error) {
+	var (
+		filter model.TodoFilter
+		err    error
+	)
+	if f == nil {
+		return filter, nil
+	}
+	filter.Done = f.Done
+	if f.TextContains != nil {
+		filter.TextContains = strings.TrimSpace(*f.TextContains)
+	}
+	filter.CreatedAfter = f.CreatedAfter
+	filter.CreatedBefore = f.CreatedBefore
+	if f.UserID != nil {
+		owner := uint(*f.UserID)
+		filter.UserID = &owner
+	}
+	if filter.TagsAny, err = normalizeTagNames(f.TagsAny); err != nil {
+		return filter, err
+	}
+	if filter.TagsAll, err = normalizeTagNames(f.TagsAll); err != nil {
+		return filter, err
+	}
+	if f.ProjectID != nil {
+		project := uint(*f.ProjectID)
+		filter.ProjectID = &project
+	}
+	filter.IncludeArchived = f.IncludeArchived != nil && *f.IncludeArchived
+	return filter, nil
+}
+
+func toTodoOrder(o *modelgen.TodoOrder) *model.Order {
+	if o == nil {
+		return nil
+	}
+	return &model.Order{
+		Column: todoOrderColumns[o.Field],
+		Desc:   o.Direction == modelgen.OrderDirectionDesc,
+	}
+}
+
+func toTodo(m *model.Todo) *modelgen.Todo {
+	todo := &modelgen.Todo{
+		ID:           int(m.ID),
+		Text:         m.Title,
+		Done:         m.Done,
+		DueAt:        m.DueAt,
+		Priority:     toTodoPriority(m.Priority),
+		RemindAt:     m.RemindAt,
+		AutoComplete: m.AutoComplete,
+		Version:      int(m.Version),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+	if m.UserID != nil {
+		userID := int(*m.UserID)
+		todo.UserID = &userID
+	}
+	if m.ParentID != nil {
+		parentID := int(*m.ParentID)
+		todo.ParentID = &parentID
+	}
+	if m.ProjectID != nil {
+		projectID := int(*m.ProjectID)
+		todo.ProjectID = &projectID
+	}
+	if m.Recurrence != "" {
+		recurrence, tz := m.Recurrence, m.RecurrenceTZ
+		todo.Recurrence, todo.RecurrenceTimeZone = &recurrence, &tz
+	}
+	return todo
+}
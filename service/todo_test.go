@@ -2,11 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"go-graph/db/model"
 	"go-graph/graph/modelgen"
+	"go-graph/pkg/apperr"
+	"go-graph/pkg/pubsub"
+	"go-graph/pkg/tenant"
 	testutil "go-graph/test"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,13 +19,24 @@ import (
 )
 
 func setupServiceTodo(mockRepo *testutil.MockRepo[model.Todo]) *ServiceTodo {
-	return NewServiceTodo(mockRepo)
+	userRepo := &testutil.MockRepo[model.User]{
+		Model: &model.User{Model: gorm.Model{ID: 1}, Name: "user 1"},
+	}
+	return newServiceTodo(&testutil.MockTodoRepo{MockRepo: mockRepo}, userRepo)
+}
+
+// newServiceTodo wires a ServiceTodo with empty tag and project
+// repositories and a small event broker.
+func newServiceTodo(todoRepo model.TodoRepo, userRepo model.UserRepo) *ServiceTodo {
+	tagRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{}}
+	projectRepo := &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{}}
+	return NewServiceTodo(todoRepo, userRepo, tagRepo, projectRepo, pubsub.NewBroker[*modelgen.TodoEvent](1), &testutil.MockStorage{})
 }
 
 func TestNewTodo(t *testing.T) {
 	var (
 		text   = "task 1"
-		userId = "user-1"
+		userId = "1"
 		id     = 1
 	)
 	mockRepo := &testutil.MockRepo[model.Todo]{
@@ -84,10 +100,454 @@ func TestGetTodos(t *testing.T) {
 		},
 	}
 	s := setupServiceTodo(mockRepo)
-	res, err := s.GetTodos(context.Background())
+	res, err := s.GetTodos(context.Background(), nil, nil, nil)
 	require.NoError(t, err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, text, res[0].Text)
 	assert.Equal(t, done, res[0].Done)
 	assert.Equal(t, id, res[0].ID)
 }
+
+func TestNewTodoEmptyText(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	_, err := s.NewTodo(context.Background(), &modelgen.NewTodo{Text: "   "})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestUpdateTodo(t *testing.T) {
+	var (
+		text = "task 2"
+		done = true
+		id   = 1
+	)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model: gorm.Model{
+				ID: uint(id),
+			},
+			Title: "task 1",
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.UpdateTodo(context.Background(), id, &modelgen.UpdateTodo{
+		Text: &text,
+		Done: &done,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, text, res.Text)
+	assert.Equal(t, done, res.Done)
+	assert.Equal(t, id, res.ID)
+}
+
+func TestSetTodoDone(t *testing.T) {
+	var (
+		text = "task 1"
+		id   = 1
+	)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model: gorm.Model{
+				ID: uint(id),
+			},
+			Title: text,
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.SetTodoDone(context.Background(), id, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, text, res.Text)
+	assert.Equal(t, true, res.Done)
+}
+
+func TestDeleteTodoNotFound(t *testing.T) {
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Err: gorm.ErrRecordNotFound,
+	}
+	s := setupServiceTodo(mockRepo)
+	_, err := s.DeleteTodo(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrTodoNotFound)
+}
+
+func TestRestoreTodo(t *testing.T) {
+	var (
+		text = "task 1"
+		id   = 1
+	)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model: gorm.Model{
+				ID: uint(id),
+			},
+			Title: text,
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.RestoreTodo(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, text, res.Text)
+	assert.Equal(t, id, res.ID)
+}
+
+func TestNewTodoInvalidUser(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	_, err := s.NewTodo(context.Background(), &modelgen.NewTodo{
+		Text:   "task 1",
+		UserID: "user-1",
+	})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestNewTodoUnknownUser(t *testing.T) {
+	userRepo := &testutil.MockRepo[model.User]{Err: gorm.ErrRecordNotFound}
+	s := newServiceTodo(&testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}, userRepo)
+	_, err := s.NewTodo(context.Background(), &modelgen.NewTodo{
+		Text:   "task 1",
+		UserID: "2",
+	})
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestGetTodosByUser(t *testing.T) {
+	var (
+		userID = 1
+		owner  = uint(userID)
+	)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{
+				Model:  gorm.Model{ID: 1},
+				Title:  "task 1",
+				UserID: &owner,
+			},
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.GetTodos(context.Background(), &userID, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	require.NotNil(t, res[0].UserID)
+	assert.Equal(t, userID, *res[0].UserID)
+}
+
+func TestGetTodosConnection(t *testing.T) {
+	var (
+		first     = 1
+		createdAt = time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+		cursor    = model.Cursor{CreatedAt: createdAt, ID: 1}
+	)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Page: &model.Page[model.Todo]{
+			Items:       []*model.Todo{{Model: gorm.Model{ID: 1, CreatedAt: createdAt}, Title: "task 1"}},
+			Cursors:     []model.Cursor{cursor},
+			HasNextPage: true,
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.GetTodosConnection(context.Background(), &first, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Edges))
+	assert.Equal(t, "task 1", res.Edges[0].Node.Text)
+	assert.Equal(t, cursor.Encode(), res.Edges[0].Cursor)
+	assert.True(t, res.PageInfo.HasNextPage)
+	require.NotNil(t, res.PageInfo.EndCursor)
+	assert.Equal(t, cursor.Encode(), *res.PageInfo.EndCursor)
+}
+
+func TestGetTodosConnectionInvalidArgs(t *testing.T) {
+	var (
+		one    = 1
+		cursor = "???"
+	)
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	_, err := s.GetTodosConnection(context.Background(), &one, nil, &one, nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, err = s.GetTodosConnection(context.Background(), &one, &cursor, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestGetTodosFilterAndOrder(t *testing.T) {
+	var (
+		done     = true
+		contains = " milk "
+		userID   = 3
+	)
+	mockRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}
+	s := newServiceTodo(mockRepo, &testutil.MockRepo[model.User]{})
+	_, err := s.GetTodos(context.Background(), nil, &modelgen.TodoFilter{
+		Done:         &done,
+		TextContains: &contains,
+		UserID:       &userID,
+	}, &modelgen.TodoOrder{
+		Field:     modelgen.TodoOrderFieldText,
+		Direction: modelgen.OrderDirectionDesc,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &done, mockRepo.Filter.Done)
+	assert.Equal(t, "milk", mockRepo.Filter.TextContains)
+	require.NotNil(t, mockRepo.Filter.UserID)
+	assert.Equal(t, uint(userID), *mockRepo.Filter.UserID)
+	assert.Equal(t, &model.Order{Column: "title", Desc: true}, mockRepo.Order)
+}
+
+func TestSubscribeTodoChanges(t *testing.T) {
+	var (
+		ownerID = 1
+		otherID = 2
+		owner   = uint(ownerID)
+		id      = 1
+	)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model:  gorm.Model{ID: uint(id)},
+			Title:  "task 1",
+			UserID: &owner,
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mine, err := s.SubscribeTodoChanges(ctx, &ownerID)
+	require.NoError(t, err)
+	theirs, err := s.SubscribeTodoChanges(ctx, &otherID)
+	require.NoError(t, err)
+
+	_, err = s.SetTodoDone(context.Background(), id, true, nil)
+	require.NoError(t, err)
+	event := <-mine
+	assert.Equal(t, modelgen.TodoEventKindUpdated, event.Kind)
+	assert.Equal(t, id, event.Todo.ID)
+	assert.True(t, event.Todo.Done)
+	assert.Empty(t, theirs)
+}
+
+func TestSubscribeTodoChangesOfTenant(t *testing.T) {
+	owner := uint(1)
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{Model: gorm.Model{ID: 1}, Title: "task 1", UserID: &owner},
+	}
+	s := setupServiceTodo(mockRepo)
+	acme := tenant.WithID(client(admin), "acme")
+	globex := tenant.WithID(client(admin), "globex")
+	ctx, cancel := context.WithCancel(acme)
+	defer cancel()
+	events, err := s.SubscribeTodoChanges(ctx, nil)
+	require.NoError(t, err)
+
+	_, err = s.SetTodoDone(globex, 1, true, nil)
+	require.NoError(t, err)
+	_, err = s.SetTodoDone(acme, 1, false, nil)
+	require.NoError(t, err)
+	event := <-events
+	assert.Equal(t, "acme", event.Tenant)
+	assert.False(t, event.Todo.Done)
+	assert.Empty(t, events)
+}
+
+func TestGetTodosByIds(t *testing.T) {
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1"},
+			{Model: gorm.Model{ID: 3}, Title: "task 3"},
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.GetTodosByIds(context.Background(), []int{3, 2, 1})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res))
+	assert.Equal(t, "task 3", res[0].Text)
+	assert.Nil(t, res[1])
+	assert.Equal(t, "task 1", res[2].Text)
+}
+
+func TestPurgeTodoNotInTrash(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{Err: gorm.ErrRecordNotFound})
+	_, err := s.PurgeTodo(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrTodoNotFound)
+}
+
+func TestPurgeTodoDeletesAttachmentBlobs(t *testing.T) {
+	files := &testutil.MockStorage{}
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}, StorageKeys: []string{"todos/1/a", "todos/1/b"}}
+	s := newServiceTodo(todoRepo, &testutil.MockRepo[model.User]{})
+	s.files = files
+	ok, err := s.PurgeTodo(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"todos/1/a", "todos/1/b"}, files.Deleted)
+}
+
+func TestGetTrashedTodos(t *testing.T) {
+	mockRepo := &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task 1"},
+		},
+	}
+	s := setupServiceTodo(mockRepo)
+	res, err := s.GetTrashedTodos(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, "task 1", res[0].Text)
+}
+
+func TestSearchTodos(t *testing.T) {
+	first := 1
+	mockRepo := &testutil.MockTodoRepo{
+		MockRepo: &testutil.MockRepo[model.Todo]{},
+		Hits: []*model.TodoSearchHit{
+			{Todo: model.Todo{Model: gorm.Model{ID: 1}, Title: "buy milk"}, Rank: 0.9, Snippet: "buy <mark>milk</mark>"},
+			{Todo: model.Todo{Model: gorm.Model{ID: 2}, Title: "milk the cow"}, Rank: 0.5, Snippet: "<mark>milk</mark> the cow"},
+		},
+	}
+	s := newServiceTodo(mockRepo, &testutil.MockRepo[model.User]{})
+	res, err := s.SearchTodos(context.Background(), "milk", &first, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Edges))
+	assert.Equal(t, "buy milk", res.Edges[0].Node.Text)
+	assert.Equal(t, "buy <mark>milk</mark>", res.Edges[0].Snippet)
+	assert.Equal(t, 0.9, res.Edges[0].Rank)
+	assert.True(t, res.PageInfo.HasNextPage)
+	assert.False(t, res.PageInfo.HasPreviousPage)
+
+	after := res.Edges[0].Cursor
+	res, err = s.SearchTodos(context.Background(), "milk", &first, &after)
+	require.NoError(t, err)
+	assert.True(t, res.PageInfo.HasPreviousPage)
+	assert.NotEqual(t, after, res.Edges[0].Cursor)
+}
+
+func TestSearchTodosEmptyQuery(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	_, err := s.SearchTodos(context.Background(), "  ", nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestRemoveTagUnknown(t *testing.T) {
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{Model: gorm.Model{ID: 1}, Title: "task"},
+	}}
+	tagRepo := &testutil.MockTagRepo{MockRepo: &testutil.MockRepo[model.Tag]{
+		Models: []*model.Tag{{Model: gorm.Model{ID: 1}, Name: "work"}},
+	}}
+	s := NewServiceTodo(todoRepo, &testutil.MockRepo[model.User]{}, tagRepo, &testutil.MockProjectRepo{MockRepo: &testutil.MockRepo[model.Project]{}}, pubsub.NewBroker[*modelgen.TodoEvent](1), &testutil.MockStorage{})
+	_, err := s.RemoveTag(context.Background(), 1, "Home")
+	assert.ErrorIs(t, err, ErrTagNotFound)
+
+	_, err = s.RemoveTag(context.Background(), 1, " Work ")
+	assert.NoError(t, err)
+}
+
+func TestUpdateTodoReschedulesReminder(t *testing.T) {
+	var (
+		old      = time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC)
+		remindAt = old.Add(24 * time.Hour)
+		high     = modelgen.TodoPriorityHigh
+		clearDue = true
+	)
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{
+			Model:      gorm.Model{ID: 1},
+			Title:      "call mom",
+			DueAt:      &old,
+			RemindAt:   &old,
+			RemindedAt: &old,
+		},
+	})
+	res, err := s.UpdateTodo(context.Background(), 1, &modelgen.UpdateTodo{
+		RemindAt:   &remindAt,
+		Priority:   &high,
+		ClearDueAt: &clearDue,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, remindAt, *res.RemindAt)
+	assert.Nil(t, res.DueAt)
+	assert.Equal(t, modelgen.TodoPriorityHigh, res.Priority)
+	todo, _ := s.repo.FindById(context.Background(), uint(1))
+	assert.Nil(t, todo.RemindedAt)
+	assert.Equal(t, model.PriorityHigh, todo.Priority)
+}
+
+func TestCreateTodosValidatesEveryItem(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	_, err := s.CreateTodos(context.Background(), []*modelgen.NewTodo{
+		{Text: "ok", UserID: "1"},
+		{Text: " ", UserID: "1"},
+		{Text: "ok", UserID: "x"},
+	})
+	var batchErr BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, 2, len(batchErr))
+	assert.Equal(t, 1, batchErr[0].Index)
+	assert.Equal(t, 2, batchErr[1].Index)
+	assert.ErrorIs(t, batchErr[0], ErrInvalidInput)
+}
+
+func TestCreateTodos(t *testing.T) {
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{})
+	res, err := s.CreateTodos(context.Background(), []*modelgen.NewTodo{
+		{Text: "first", UserID: "1"},
+		{Text: "second", UserID: "1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	assert.Equal(t, "second", res[1].Text)
+}
+
+func TestCreateTodosAbortsOnInternalError(t *testing.T) {
+	dbErr := errors.New("pq: connection refused")
+	s := newServiceTodo(&testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{}}, &testutil.MockRepo[model.User]{Err: dbErr})
+	_, err := s.CreateTodos(context.Background(), []*modelgen.NewTodo{
+		{Text: " ", UserID: "1"},
+		{Text: "ok", UserID: "1"},
+	})
+	var batchErr BatchError
+	assert.False(t, errors.As(err, &batchErr))
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func TestUpdateTodosRollsBackWhenSpawnFails(t *testing.T) {
+	due := time.Now().Add(48 * time.Hour).UTC()
+	todoRepo := &testutil.MockTodoRepo{MockRepo: &testutil.MockRepo[model.Todo]{
+		Models: []*model.Todo{
+			{Model: gorm.Model{ID: 1}, Title: "task"},
+			{Model: gorm.Model{ID: 2}, Title: "chores", DueAt: &due, Recurrence: "FREQ=WEEKLY", RecurrenceTZ: "Mars/Olympus_Mons"},
+		},
+	}}
+	s := newServiceTodo(todoRepo, &testutil.MockRepo[model.User]{})
+	done := true
+	_, err := s.UpdateTodos(context.Background(), []*modelgen.TodoPatch{
+		{ID: 1, Changes: &modelgen.UpdateTodo{Done: &done}},
+		{ID: 2, Changes: &modelgen.UpdateTodo{Done: &done}},
+	})
+	var batchErr BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, 1, len(batchErr))
+	assert.Equal(t, 1, batchErr[0].Index)
+	assert.Equal(t, 1, todoRepo.RolledBack)
+}
+
+func TestUpdateTodoStaleVersion(t *testing.T) {
+	var (
+		text     = "edited"
+		expected = 2
+	)
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{
+		Model: &model.Todo{Model: gorm.Model{ID: 1}, Title: "task 1", Version: 3},
+	})
+	_, err := s.UpdateTodo(context.Background(), 1, &modelgen.UpdateTodo{Text: &text}, &expected)
+	var appErr *apperr.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, apperr.CodeConflict, appErr.Code)
+	current := appErr.Details["current"].(*modelgen.Todo)
+	assert.Equal(t, 3, current.Version)
+	assert.Equal(t, "task 1", current.Text)
+}
+
+func TestUpdateTodoLostRace(t *testing.T) {
+	text := "edited"
+	s := setupServiceTodo(&testutil.MockRepo[model.Todo]{
+		Model:     &model.Todo{Model: gorm.Model{ID: 1}, Title: "task 1", Version: 3},
+		UpdateErr: model.ErrVersionConflict,
+	})
+	_, err := s.UpdateTodo(context.Background(), 1, &modelgen.UpdateTodo{Text: &text}, nil)
+	assert.Equal(t, apperr.CodeConflict, apperr.CodeOf(err))
+}
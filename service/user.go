@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	"strings"
+)
+
+type ServiceUser struct {
+	repo model.UserRepo
+}
+
+func NewServiceUser(repo model.UserRepo) *ServiceUser {
+	return &ServiceUser{
+		repo: repo,
+	}
+}
+
+func (s *ServiceUser) NewUser(ctx context.Context, input *modelgen.NewUser) (*modelgen.User, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", ErrInvalidInput)
+	}
+	res, err := s.repo.Create(ctx, &model.User{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toUser(res), nil
+}
+
+// GetMe returns the user of the authenticated principal.
+func (s *ServiceUser) GetMe(ctx context.Context) (*modelgen.User, error) {
+	p, err := auth.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, int(p.UserID))
+}
+
+// GetUser returns the user id. Callers restricted by todoScope may only
+// look up themselves.
+func (s *ServiceUser) GetUser(ctx context.Context, id int) (*modelgen.User, error) {
+	if err := authorizeOwner(ctx, uint(id)); err != nil {
+		return nil, err
+	}
+	if id <= 0 {
+		return nil, ErrUserNotFound
+	}
+	res, err := s.repo.FindById(ctx, uint(id))
+	if err != nil {
+		return nil, notFound(err, ErrUserNotFound)
+	}
+	return toUser(res), nil
+}
+
+// GetUsersByIds loads users in a single query. The result is aligned with
+// ids and holds nil where a user does not exist.
+func (s *ServiceUser) GetUsersByIds(ctx context.Context, ids []int) ([]*modelgen.User, error) {
+	users := make([]*modelgen.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+	keys := make([]any, len(ids))
+	for i, id := range ids {
+		keys[i] = uint(id)
+	}
+	res, err := s.repo.FindAllByIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*model.User, len(res))
+	for _, v := range res {
+		byID[int(v.ID)] = v
+	}
+	for i, id := range ids {
+		if v, ok := byID[id]; ok {
+			users[i] = toUser(v)
+		}
+	}
+	return users, nil
+}
+
+func (s *ServiceUser) GetUsers(ctx context.Context) ([]*modelgen.User, error) {
+	res, err := s.repo.FindAllByIds(ctx, []any{})
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*modelgen.User, len(res))
+	for i, v := range res {
+		users[i] = toUser(v)
+	}
+	return users, nil
+}
+
+func toUser(m *model.User) *modelgen.User {
+	return &modelgen.User{ID: int(m.ID), Name: m.Name}
+}
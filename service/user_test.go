@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"go-graph/db/model"
+	"go-graph/graph/modelgen"
+	"go-graph/pkg/auth"
+	testutil "go-graph/test"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestNewUser(t *testing.T) {
+	var (
+		name = "user 1"
+		id   = 1
+	)
+	mockRepo := &testutil.MockRepo[model.User]{
+		Model: &model.User{
+			Model: gorm.Model{
+				ID: uint(id),
+			},
+			Name: name,
+		},
+	}
+	s := NewServiceUser(mockRepo)
+	res, err := s.NewUser(context.Background(), &modelgen.NewUser{Name: name})
+	require.NoError(t, err)
+	assert.Equal(t, name, res.Name)
+	assert.Equal(t, id, res.ID)
+}
+
+func TestGetUserNotFound(t *testing.T) {
+	s := NewServiceUser(&testutil.MockRepo[model.User]{Err: gorm.ErrRecordNotFound})
+	_, err := s.GetUser(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestGetUserOfOtherUserIsForbidden(t *testing.T) {
+	s := NewServiceUser(&testutil.MockRepo[model.User]{
+		Model: &model.User{Model: gorm.Model{ID: 2}, Name: "bob"},
+	})
+	_, err := s.GetUser(client(nil), 2)
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	_, err = s.GetUser(client(alice), 2)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	res, err := s.GetUser(client(admin), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", res.Name)
+}
+
+func TestGetMe(t *testing.T) {
+	s := NewServiceUser(&testutil.MockRepo[model.User]{
+		Model: &model.User{Model: gorm.Model{ID: 7}, Name: "alice"},
+	})
+	_, err := s.GetMe(context.Background())
+	assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "7", UserID: 7})
+	res, err := s.GetMe(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", res.Name)
+
+	ctx = auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "service-account"})
+	_, err = s.GetMe(ctx)
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
@@ -0,0 +1,41 @@
+package testutil
+
+import (
+	"context"
+	"go-graph/db/model"
+)
+
+type MockAttachmentRepo struct {
+	*MockRepo[model.Attachment]
+	// TodoLookups records the todo ids of every FindByTodoIds call.
+	TodoLookups [][]uint
+}
+
+// Create returns t with ID 1, unlike MockRepo, so that the fields filled in
+// by the caller can be checked.
+func (r *MockAttachmentRepo) Create(ctx context.Context, t *model.Attachment) (*model.Attachment, error) {
+	r.Created = append(r.Created, t)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	t.ID = 1
+	return t, nil
+}
+
+// FindByTodoIds returns the Models attached to one of todoIDs.
+func (r *MockAttachmentRepo) FindByTodoIds(ctx context.Context, todoIDs []uint) ([]*model.Attachment, error) {
+	r.TodoLookups = append(r.TodoLookups, todoIDs)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var res []*model.Attachment
+	for _, m := range r.Models {
+		for _, id := range todoIDs {
+			if m.TodoID == id {
+				res = append(res, m)
+				break
+			}
+		}
+	}
+	return res, nil
+}
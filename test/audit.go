@@ -0,0 +1,28 @@
+package testutil
+
+import (
+	"context"
+	"go-graph/db/model"
+)
+
+type MockAuditRepo struct {
+	// Events is returned by every query; Filter records the last filter.
+	Events []*model.AuditEvent
+	Filter model.AuditFilter
+	Err    error
+}
+
+func (r *MockAuditRepo) FindFiltered(ctx context.Context, filter model.AuditFilter, limit int) ([]*model.AuditEvent, error) {
+	r.Filter = filter
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Events, nil
+}
+
+func (r *MockAuditRepo) FindByEntityIds(ctx context.Context, entity string, ids []uint) ([]*model.AuditEvent, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Events, nil
+}
@@ -0,0 +1,32 @@
+package testutil
+
+import (
+	"context"
+	"go-graph/db/model"
+)
+
+type MockCommentRepo struct {
+	*MockRepo[model.Comment]
+	// FirstPageLookups records the todo ids of every FindFirstByTodoIds call.
+	FirstPageLookups [][]uint
+}
+
+// FindFirstByTodoIds returns up to limit of the Models on each of todoIDs.
+func (r *MockCommentRepo) FindFirstByTodoIds(ctx context.Context, todoIDs []uint, limit int) ([]*model.Comment, error) {
+	r.FirstPageLookups = append(r.FirstPageLookups, todoIDs)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	wanted := make(map[uint]int, len(todoIDs))
+	for _, id := range todoIDs {
+		wanted[id] = 0
+	}
+	var res []*model.Comment
+	for _, m := range r.Models {
+		if n, ok := wanted[m.TodoID]; ok && n < limit {
+			wanted[m.TodoID] = n + 1
+			res = append(res, m)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,20 @@
+package testutil
+
+import (
+	"context"
+	"go-graph/db/model"
+)
+
+type MockProjectRepo struct {
+	*MockRepo[model.Project]
+	// IncludeArchived records the argument of the last FindAll call.
+	IncludeArchived bool
+}
+
+func (r *MockProjectRepo) FindAll(ctx context.Context, includeArchived bool) ([]*model.Project, error) {
+	r.IncludeArchived = includeArchived
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Models, nil
+}
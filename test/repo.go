@@ -1,26 +1,102 @@
 package testutil
 
+import (
+	"context"
+	"go-graph/db/model"
+	"time"
+)
+
 type MockRepo[T any] struct {
 	Model  *T
 	Models []*T
+	// Page is returned by Paginate; when nil a page of Models is built.
+	Page *model.Page[T]
+	// Err is returned by every method when set.
+	Err error
+	// UpdateErr is returned by Update only, e.g. model.ErrVersionConflict.
+	UpdateErr error
+	// Lookups records the ids of every FindAllByIds call.
+	Lookups [][]any
+	// Created records the argument of every Create call.
+	Created []*T
 }
 
-func (r *MockRepo[T]) Create(t *T) (*T, error) {
+func (r *MockRepo[T]) Create(ctx context.Context, t *T) (*T, error) {
+	r.Created = append(r.Created, t)
+	if r.Err != nil {
+		return nil, r.Err
+	}
 	return r.Model, nil
 }
 
-func (r *MockRepo[T]) Update(t *T) (*T, error) {
+// CreateInBatches returns ts unchanged.
+func (r *MockRepo[T]) CreateInBatches(ctx context.Context, ts []*T, batchSize int) ([]*T, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return ts, nil
+}
+
+func (r *MockRepo[T]) Update(ctx context.Context, t *T) (*T, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	if r.UpdateErr != nil {
+		return nil, r.UpdateErr
+	}
+	return t, nil
+}
+
+func (r *MockRepo[T]) Delete(ctx context.Context, t *T) error {
+	return r.Err
+}
+
+func (r *MockRepo[T]) Restore(ctx context.Context, id any) (*T, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
 	return r.Model, nil
 }
 
-func (r *MockRepo[T]) Delete(t *T) error {
-	return nil
+func (r *MockRepo[T]) HardDelete(ctx context.Context, id any) error {
+	return r.Err
 }
 
-func (r *MockRepo[T]) FindById(id any) (*T, error) {
+func (r *MockRepo[T]) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	if r.Err != nil {
+		return 0, r.Err
+	}
+	return int64(len(r.Models)), nil
+}
+
+func (r *MockRepo[T]) FindDeleted(ctx context.Context) ([]*T, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Models, nil
+}
+
+func (r *MockRepo[T]) FindById(ctx context.Context, id any) (*T, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
 	return r.Model, nil
 }
 
-func (r *MockRepo[T]) FindAllByIds(id []any) ([]*T, error) {
+func (r *MockRepo[T]) FindAllByIds(ctx context.Context, id []any) ([]*T, error) {
+	r.Lookups = append(r.Lookups, id)
+	if r.Err != nil {
+		return nil, r.Err
+	}
 	return r.Models, nil
 }
+
+func (r *MockRepo[T]) Paginate(ctx context.Context, req model.PageRequest) (*model.Page[T], error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	if r.Page != nil {
+		return r.Page, nil
+	}
+	return &model.Page[T]{Items: r.Models, Cursors: make([]model.Cursor, len(r.Models))}, nil
+}
@@ -0,0 +1,40 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"go-graph/pkg/storage"
+	"io"
+)
+
+// MockStorage keeps blobs in memory and records deleted keys.
+type MockStorage struct {
+	Blobs   map[string][]byte
+	Deleted []string
+}
+
+func (s *MockStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if s.Blobs == nil {
+		s.Blobs = map[string][]byte{}
+	}
+	s.Blobs[key] = b
+	return nil
+}
+
+func (s *MockStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	b, ok := s.Blobs[key]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (s *MockStorage) Delete(ctx context.Context, key string) error {
+	delete(s.Blobs, key)
+	s.Deleted = append(s.Deleted, key)
+	return nil
+}
@@ -0,0 +1,45 @@
+package testutil
+
+import (
+	"context"
+	"go-graph/db/model"
+
+	"gorm.io/gorm"
+)
+
+type MockTagRepo struct {
+	*MockRepo[model.Tag]
+	// TodoTags is returned by FindByTodoIds.
+	TodoTags []*model.TodoTag
+}
+
+// FindByName looks name up in Models when it is set and falls back to Model
+// otherwise.
+func (r *MockTagRepo) FindByName(ctx context.Context, name string) (*model.Tag, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	if r.Models == nil {
+		return r.Model, nil
+	}
+	for _, m := range r.Models {
+		if m.Name == name {
+			return m, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *MockTagRepo) FindOrCreateByName(ctx context.Context, name string) (*model.Tag, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Model, nil
+}
+
+func (r *MockTagRepo) FindByTodoIds(ctx context.Context, todoIDs []uint) ([]*model.TodoTag, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.TodoTags, nil
+}
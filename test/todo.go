@@ -0,0 +1,248 @@
+package testutil
+
+import (
+	"context"
+	"go-graph/db/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MockTodoRepo struct {
+	*MockRepo[model.Todo]
+	// Filter and Order record the arguments of the last FindFiltered call.
+	Filter model.TodoFilter
+	Order  *model.Order
+	// Hits is returned by Search.
+	Hits []*model.TodoSearchHit
+	// Reminders is handed out once by ClaimDueReminders; Released records
+	// the ids passed to ReleaseReminder.
+	Reminders []*model.Todo
+	Released  []uint
+	// Recurrences is returned by FindDueRecurrences.
+	Recurrences []*model.Todo
+	// Tree is an in-memory set of todos linked by ParentID. When set, FindById
+	// and the subtask queries are answered from it.
+	Tree []*model.Todo
+	// StorageKeys is returned by the purge methods as the keys of the
+	// attachments removed with the todos.
+	StorageKeys []string
+	// RolledBack counts the transactions whose function failed.
+	RolledBack int
+	// ProjectLookups records the ids of every FindByProjectIds call.
+	ProjectLookups [][]uint
+}
+
+func (r *MockTodoRepo) FindFiltered(ctx context.Context, filter model.TodoFilter, order *model.Order) ([]*model.Todo, error) {
+	r.Filter, r.Order = filter, order
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Models, nil
+}
+
+func (r *MockTodoRepo) Search(ctx context.Context, query string, userID *uint, limit, offset int) ([]*model.TodoSearchHit, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Hits, nil
+}
+
+func (r *MockTodoRepo) AddTags(ctx context.Context, todo *model.Todo, tags ...*model.Tag) error {
+	return r.Err
+}
+
+func (r *MockTodoRepo) RemoveTags(ctx context.Context, todo *model.Todo, tags ...*model.Tag) error {
+	return r.Err
+}
+
+func (r *MockTodoRepo) ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]*model.Todo, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	n := len(r.Reminders)
+	if n > limit {
+		n = limit
+	}
+	claimed := r.Reminders[:n]
+	r.Reminders = r.Reminders[n:]
+	return claimed, nil
+}
+
+func (r *MockTodoRepo) ReleaseReminder(ctx context.Context, id uint) error {
+	r.Released = append(r.Released, id)
+	return r.Err
+}
+
+func (r *MockTodoRepo) FindDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*model.Todo, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Recurrences, nil
+}
+
+func (r *MockTodoRepo) FindById(ctx context.Context, id any) (*model.Todo, error) {
+	if r.Tree == nil {
+		return r.MockRepo.FindById(ctx, id)
+	}
+	if t := r.node(id.(uint)); t != nil {
+		return t, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *MockTodoRepo) FindChildren(ctx context.Context, parentIDs []uint) ([]*model.Todo, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var children []*model.Todo
+	for _, t := range r.Tree {
+		for _, id := range parentIDs {
+			if t.ParentID != nil && *t.ParentID == id {
+				children = append(children, t)
+			}
+		}
+	}
+	return children, nil
+}
+
+// FindByProjectIds returns the Models in the given projects owned by userID
+// and records projectIDs in ProjectLookups.
+func (r *MockTodoRepo) FindByProjectIds(ctx context.Context, projectIDs []uint, userID *uint) ([]*model.Todo, error) {
+	r.ProjectLookups = append(r.ProjectLookups, projectIDs)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var todos []*model.Todo
+	for _, t := range r.Models {
+		for _, id := range projectIDs {
+			if t.ProjectID != nil && *t.ProjectID == id && ownedBy(t, userID) {
+				todos = append(todos, t)
+			}
+		}
+	}
+	return todos, nil
+}
+
+func (r *MockTodoRepo) CountChildren(ctx context.Context, parentIDs []uint, userID *uint) ([]*model.ChildCount, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	children, _ := r.FindChildren(ctx, parentIDs)
+	var counts []*model.ChildCount
+	for _, id := range parentIDs {
+		c := &model.ChildCount{ParentID: id}
+		for _, t := range children {
+			if userID != nil && (t.UserID == nil || *t.UserID != *userID) {
+				continue
+			}
+			if *t.ParentID == id {
+				c.Total++
+				if t.Done {
+					c.Completed++
+				}
+			}
+		}
+		if c.Total > 0 {
+			counts = append(counts, c)
+		}
+	}
+	return counts, nil
+}
+
+func (r *MockTodoRepo) FindLineage(ctx context.Context, id uint, limit int) ([]uint, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var ids []uint
+	for t := r.node(id); t != nil && len(ids) < limit; {
+		ids = append(ids, t.ID)
+		if t.ParentID == nil {
+			break
+		}
+		t = r.node(*t.ParentID)
+	}
+	return ids, nil
+}
+
+func (r *MockTodoRepo) SubtreeDepth(ctx context.Context, id uint, limit int) (int, error) {
+	if r.Err != nil {
+		return 0, r.Err
+	}
+	depth := 0
+	for level := []uint{id}; depth < limit; depth++ {
+		children, _ := r.FindChildren(ctx, level)
+		if len(children) == 0 {
+			break
+		}
+		level = level[:0]
+		for _, c := range children {
+			level = append(level, c.ID)
+		}
+	}
+	return depth, nil
+}
+
+// FindTrashed returns the Models owned by userID, all of them when it is nil.
+func (r *MockTodoRepo) FindTrashed(ctx context.Context, userID *uint) ([]*model.Todo, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var trashed []*model.Todo
+	for _, t := range r.Models {
+		if ownedBy(t, userID) {
+			trashed = append(trashed, t)
+		}
+	}
+	return trashed, nil
+}
+
+func (r *MockTodoRepo) FindTrashedById(ctx context.Context, id uint, userID *uint) (*model.Todo, error) {
+	trashed, err := r.FindTrashed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range trashed {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func ownedBy(t *model.Todo, userID *uint) bool {
+	return userID == nil || (t.UserID != nil && *t.UserID == *userID)
+}
+
+func (r *MockTodoRepo) PurgeTodo(ctx context.Context, id uint) ([]string, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.StorageKeys, nil
+}
+
+func (r *MockTodoRepo) PurgeTodosDeletedBefore(ctx context.Context, before time.Time) (int64, []string, error) {
+	if r.Err != nil {
+		return 0, nil, r.Err
+	}
+	return int64(len(r.Models)), r.StorageKeys, nil
+}
+
+// Transaction runs fn against the mock itself; nothing is rolled back but
+// failures are counted in RolledBack.
+func (r *MockTodoRepo) Transaction(ctx context.Context, fn func(tx model.TodoRepo) error) error {
+	err := fn(r)
+	if err != nil {
+		r.RolledBack++
+	}
+	return err
+}
+
+func (r *MockTodoRepo) node(id uint) *model.Todo {
+	for _, t := range r.Tree {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}